@@ -0,0 +1,156 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+)
+
+// contextKey identifies the result of a flag evaluated for an evaluation context
+type contextKey struct {
+	flagKey     string
+	contextHash uint64
+}
+
+// contextEntry is a cached result along with the time it expires at, zero if it does not expire
+type contextEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// ContextCache caches evaluation results by flag key and a hash of the evaluation context they were evaluated for.
+// Entries expire after the TTL, and once the size limit is reached each new entry replaces the least recently used
+// entry. Expired entries are dropped when looked up or evicted, so no background goroutine outlives the cache.
+//
+// Results are added along with the generation the cache had when their evaluation started, see Generation. Results of
+// evaluations that started before the flag was removed or the cache was purged are dropped, so a slow evaluation can
+// not put back a result that is no longer valid.
+type ContextCache struct {
+	mu       sync.Mutex
+	cache    *simplelru.LRU[contextKey, contextEntry]
+	ttl      time.Duration
+	disabled bool
+
+	generation uint64
+	// removed holds the generation at which each flag was last removed
+	removed map[string]uint64
+	purged  uint64
+
+	// index holds the context hashes cached per flag, so the results of a flag are removed without scanning all keys
+	index map[string]map[uint64]struct{}
+}
+
+// NewContextCache creates a ContextCache holding up to size entries for the given TTL. A TTL of zero or less disables
+// the expiry of the entries. The size must be positive.
+func NewContextCache(size int, ttl time.Duration) *ContextCache {
+	c := &ContextCache{
+		ttl:     ttl,
+		removed: make(map[string]uint64),
+		index:   make(map[string]map[uint64]struct{}),
+	}
+	// creating an LRU only fails for sizes below 1
+	c.cache, _ = simplelru.NewLRU[contextKey, contextEntry](max(size, 1), c.onEvict)
+	return c
+}
+
+// Generation returns the current generation of the cache, to be passed to Add for a result evaluated from now on
+func (c *ContextCache) Generation() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+// Add caches the result of the flag for the evaluation context, unless the flag was removed or the cache was purged
+// after the given generation
+func (c *ContextCache) Add(flagKey string, contextHash uint64, generation uint64, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.disabled || c.removed[flagKey] > generation || c.purged > generation {
+		return
+	}
+
+	entry := contextEntry{value: value}
+	if c.ttl > 0 {
+		entry.expiresAt = time.Now().Add(c.ttl)
+	}
+	c.cache.Add(contextKey{flagKey: flagKey, contextHash: contextHash}, entry)
+
+	hashes, ok := c.index[flagKey]
+	if !ok {
+		hashes = make(map[uint64]struct{})
+		c.index[flagKey] = hashes
+	}
+	hashes[contextHash] = struct{}{}
+}
+
+func (c *ContextCache) Get(flagKey string, contextHash uint64) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.disabled {
+		return nil, false
+	}
+	key := contextKey{flagKey: flagKey, contextHash: contextHash}
+	entry, ok := c.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		c.cache.Remove(key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// RemoveFlag removes the results of the flag for all evaluation contexts
+func (c *ContextCache) RemoveFlag(flagKey string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	c.removed[flagKey] = c.generation
+
+	for hash := range c.index[flagKey] {
+		c.cache.Remove(contextKey{flagKey: flagKey, contextHash: hash})
+	}
+	delete(c.index, flagKey)
+}
+
+func (c *ContextCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.purgeLocked()
+}
+
+func (c *ContextCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Len()
+}
+
+// Disable purges the cache and stops caching, e.g. once changes of the flags can no longer be observed
+func (c *ContextCache) Disable() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.disabled = true
+	c.purgeLocked()
+}
+
+func (c *ContextCache) purgeLocked() {
+	c.generation++
+	c.purged = c.generation
+	// removals before the purge are covered by it
+	clear(c.removed)
+	c.cache.Purge()
+	clear(c.index)
+}
+
+// onEvict keeps the index in line with the entries removed from the LRU, whether evicted, expired or removed. It is
+// called by the LRU while the lock is held.
+func (c *ContextCache) onEvict(key contextKey, _ contextEntry) {
+	if hashes, ok := c.index[key.flagKey]; ok {
+		delete(hashes, key.contextHash)
+		if len(hashes) == 0 {
+			delete(c.index, key.flagKey)
+		}
+	}
+}
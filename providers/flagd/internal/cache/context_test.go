@@ -0,0 +1,98 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestContextCache(t *testing.T) {
+	t.Run("results are removed per flag", func(t *testing.T) {
+		c := NewContextCache(10, 0)
+		generation := c.Generation()
+		c.Add("flag", 1, generation, "a")
+		c.Add("flag", 2, generation, "b")
+		c.Add("other", 1, generation, "c")
+
+		c.RemoveFlag("flag")
+
+		if _, ok := c.Get("flag", 1); ok {
+			t.Error("expected the results of the flag to be removed")
+		}
+		if _, ok := c.Get("flag", 2); ok {
+			t.Error("expected the results of the flag to be removed")
+		}
+		if value, ok := c.Get("other", 1); !ok || value != "c" {
+			t.Errorf("expected the results of other flags to be kept, got %v", value)
+		}
+		if len(c.index) != 1 {
+			t.Errorf("expected only the other flag to be indexed, got %v", c.index)
+		}
+	})
+
+	t.Run("results evaluated before a removal are dropped", func(t *testing.T) {
+		c := NewContextCache(10, 0)
+		before := c.Generation()
+		c.RemoveFlag("flag")
+
+		c.Add("flag", 1, before, "outdated")
+		if _, ok := c.Get("flag", 1); ok {
+			t.Error("expected the result evaluated before the removal to be dropped")
+		}
+		c.Add("other", 1, before, "unchanged")
+		if _, ok := c.Get("other", 1); !ok {
+			t.Error("expected the result of another flag to be cached")
+		}
+		c.Add("flag", 1, c.Generation(), "current")
+		if value, ok := c.Get("flag", 1); !ok || value != "current" {
+			t.Errorf("expected the result evaluated after the removal to be cached, got %v", value)
+		}
+	})
+
+	t.Run("results evaluated before a purge are dropped", func(t *testing.T) {
+		c := NewContextCache(10, 0)
+		before := c.Generation()
+		c.Add("flag", 1, before, "a")
+		c.Purge()
+
+		if c.Len() != 0 || len(c.index) != 0 {
+			t.Errorf("expected the cache to be purged, got %d results indexed as %v", c.Len(), c.index)
+		}
+		c.Add("other", 1, before, "outdated")
+		if c.Len() != 0 {
+			t.Error("expected the result evaluated before the purge to be dropped")
+		}
+		c.Add("other", 1, c.Generation(), "current")
+		if c.Len() != 1 {
+			t.Error("expected the result evaluated after the purge to be cached")
+		}
+	})
+
+	t.Run("evicted and expired results leave the index", func(t *testing.T) {
+		c := NewContextCache(1, 0)
+		c.Add("flag", 1, c.Generation(), "a")
+		c.Add("other", 1, c.Generation(), "b")
+		if _, ok := c.index["flag"]; ok {
+			t.Errorf("expected the evicted result to leave the index, got %v", c.index)
+		}
+
+		c = NewContextCache(10, 10*time.Millisecond)
+		c.Add("flag", 1, c.Generation(), "a")
+		time.Sleep(20 * time.Millisecond)
+		if _, ok := c.Get("flag", 1); ok {
+			t.Error("expected the result to expire")
+		}
+		if c.Len() != 0 || len(c.index) != 0 {
+			t.Errorf("expected the expired result to be removed, got %d results indexed as %v", c.Len(), c.index)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		c := NewContextCache(10, 0)
+		c.Add("flag", 1, c.Generation(), "a")
+		c.Disable()
+		c.Add("flag", 1, c.Generation(), "a")
+		if _, ok := c.Get("flag", 1); ok || c.Len() != 0 {
+			t.Error("expected nothing to be cached once disabled")
+		}
+	})
+}
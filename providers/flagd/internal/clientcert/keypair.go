@@ -0,0 +1,97 @@
+package clientcert
+
+import (
+	"crypto/tls"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// fileVersion identifies the content of a file by its modification time and size
+type fileVersion struct {
+	modTime time.Time
+	size    int64
+}
+
+// KeyPair loads a client certificate and its key, and reloads them once either file changed, e.g. when the files are
+// rotated by a certificate manager. The files are checked on each TLS handshake through GetClientCertificate, so new
+// connections use the current certificate without restarting the provider.
+type KeyPair struct {
+	certPath string
+	keyPath  string
+
+	mu          sync.Mutex
+	certificate *tls.Certificate
+	certVersion fileVersion
+	keyVersion  fileVersion
+}
+
+// NewKeyPair loads the client certificate and its key from the PEM encoded files
+func NewKeyPair(certPath string, keyPath string) (*KeyPair, error) {
+	if certPath == "" || keyPath == "" {
+		return nil, fmt.Errorf("a client certificate requires both a certificate and a key file, got %q and %q",
+			certPath, keyPath)
+	}
+
+	k := &KeyPair{certPath: certPath, keyPath: keyPath}
+	if err := k.reload(); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// GetClientCertificate returns the current client certificate, reloading it first if the files changed. If the changed
+// files can not be loaded, e.g. as only one of them was written yet, the previous certificate is returned and loading is
+// attempted again on the next handshake.
+func (k *KeyPair) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.changed() {
+		_ = k.reload()
+	}
+	return k.certificate, nil
+}
+
+// changed reports whether either file changed since it was loaded
+func (k *KeyPair) changed() bool {
+	certVersion, err := versionOf(k.certPath)
+	if err != nil {
+		return false
+	}
+	keyVersion, err := versionOf(k.keyPath)
+	if err != nil {
+		return false
+	}
+	return certVersion != k.certVersion || keyVersion != k.keyVersion
+}
+
+func (k *KeyPair) reload() error {
+	certVersion, err := versionOf(k.certPath)
+	if err != nil {
+		return err
+	}
+	keyVersion, err := versionOf(k.keyPath)
+	if err != nil {
+		return err
+	}
+
+	certificate, err := tls.LoadX509KeyPair(k.certPath, k.keyPath)
+	if err != nil {
+		return fmt.Errorf("unable to load client certificate %s with key %s: %w", k.certPath, k.keyPath, err)
+	}
+
+	k.certificate = &certificate
+	k.certVersion = certVersion
+	k.keyVersion = keyVersion
+	return nil
+}
+
+func versionOf(path string) (fileVersion, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileVersion{}, fmt.Errorf("unable to read file %s: %w", path, err)
+	}
+	return fileVersion{modTime: info.ModTime(), size: info.Size()}, nil
+}
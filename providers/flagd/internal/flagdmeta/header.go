@@ -3,3 +3,11 @@ package flagdmeta
 
 // Selector is the metadata header used to pass the flag-set selector to flagd.
 const Selector = "flagd-selector"
+
+// Authorization is the metadata header used to pass the token authenticating requests to flagd.
+const Authorization = "authorization"
+
+// BearerToken returns the value of the Authorization header for the token.
+func BearerToken(token string) string {
+	return "Bearer " + token
+}
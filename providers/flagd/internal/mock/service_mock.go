@@ -148,3 +148,78 @@ func (mr *MockIServiceMockRecorder) Shutdown() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shutdown", reflect.TypeOf((*MockIService)(nil).Shutdown))
 }
+
+// MockIBulkService is a mock of IBulkService interface.
+type MockIBulkService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBulkServiceMockRecorder
+}
+
+// MockIBulkServiceMockRecorder is the mock recorder for MockIBulkService.
+type MockIBulkServiceMockRecorder struct {
+	mock *MockIBulkService
+}
+
+// NewMockIBulkService creates a new mock instance.
+func NewMockIBulkService(ctrl *gomock.Controller) *MockIBulkService {
+	mock := &MockIBulkService{ctrl: ctrl}
+	mock.recorder = &MockIBulkServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBulkService) EXPECT() *MockIBulkServiceMockRecorder {
+	return m.recorder
+}
+
+// ResolveAll mocks base method.
+func (m *MockIBulkService) ResolveAll(ctx context.Context, flagKeys []string, evalCtx map[string]any) (context.Context, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveAll", ctx, flagKeys, evalCtx)
+	ret0, _ := ret[0].(context.Context)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveAll indicates an expected call of ResolveAll.
+func (mr *MockIBulkServiceMockRecorder) ResolveAll(ctx, flagKeys, evalCtx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveAll", reflect.TypeOf((*MockIBulkService)(nil).ResolveAll), ctx, flagKeys, evalCtx)
+}
+
+// MockISnapshotService is a mock of ISnapshotService interface.
+type MockISnapshotService struct {
+	ctrl     *gomock.Controller
+	recorder *MockISnapshotServiceMockRecorder
+}
+
+// MockISnapshotServiceMockRecorder is the mock recorder for MockISnapshotService.
+type MockISnapshotServiceMockRecorder struct {
+	mock *MockISnapshotService
+}
+
+// NewMockISnapshotService creates a new mock instance.
+func NewMockISnapshotService(ctrl *gomock.Controller) *MockISnapshotService {
+	mock := &MockISnapshotService{ctrl: ctrl}
+	mock.recorder = &MockISnapshotServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISnapshotService) EXPECT() *MockISnapshotServiceMockRecorder {
+	return m.recorder
+}
+
+// LoadedSnapshot mocks base method.
+func (m *MockISnapshotService) LoadedSnapshot() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadedSnapshot")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// LoadedSnapshot indicates an expected call of LoadedSnapshot.
+func (mr *MockISnapshotServiceMockRecorder) LoadedSnapshot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadedSnapshot", reflect.TypeOf((*MockISnapshotService)(nil).LoadedSnapshot))
+}
@@ -1,11 +1,14 @@
 package flagd
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/open-feature/flagd/core/pkg/sync"
@@ -17,6 +20,16 @@ import (
 
 type ResolverType string
 
+// TokenSource provides the token authenticating the requests to flagd, sent as bearer token in the Authorization header.
+// It is called for each request and stream, so it can refresh an expired token, and should return a cached token
+// otherwise.
+type TokenSource func(ctx context.Context) (string, error)
+
+// CustomOperator is a JSONLogic operator usable in the targeting rules of flags evaluated in-process. It is called with
+// the evaluated arguments of the operator as values, e.g. `[]any{"10.0.0.1", "10.0.0.0/8"}`, and with the evaluation
+// context as data, including the targetingKey and the `$flagd` properties. Its result is used as the operator's value.
+type CustomOperator func(values, data any) any
+
 // Naming and defaults must comply with flagd environment variables
 const (
 	// DefaultRetryBackoffMs is the default initial backoff duration for stream retry
@@ -46,6 +59,8 @@ const (
 	flagdTLSEnvironmentVariableName                   = "FLAGD_TLS"
 	flagdSocketPathEnvironmentVariableName            = "FLAGD_SOCKET_PATH"
 	flagdServerCertPathEnvironmentVariableName        = "FLAGD_SERVER_CERT_PATH"
+	flagdClientCertPathEnvironmentVariableName        = "FLAGD_CLIENT_CERT_PATH"
+	flagdClientKeyPathEnvironmentVariableName         = "FLAGD_CLIENT_KEY_PATH"
 	flagdCacheEnvironmentVariableName                 = "FLAGD_CACHE"
 	flagdMaxCacheSizeEnvironmentVariableName          = "FLAGD_MAX_CACHE_SIZE"
 	flagdMaxEventStreamRetriesEnvironmentVariableName = "FLAGD_MAX_EVENT_STREAM_RETRIES"
@@ -63,11 +78,19 @@ const (
 
 type ProviderConfiguration struct {
 	Cache                            cache.Type
+	ContextCacheSize                 int
+	ContextCacheTTL                  time.Duration
 	CertPath                         string
+	ClientCertPath                   string
+	ClientKeyPath                    string
+	TokenSource                      TokenSource
+	SnapshotPath                     string
+	CustomOperators                  map[string]CustomOperator
 	EventStreamConnectionMaxAttempts int
 	Host                             string
 	MaxCacheSize                     int
 	OfflineFlagSourcePath            string
+	OfflineFlagSourcePaths           []string
 	OtelIntercept                    bool
 	Port                             uint16
 	TargetUri                        string
@@ -129,7 +152,7 @@ func NewProviderConfiguration(opts []ProviderOption) (*ProviderConfiguration, er
 }
 
 func configureProviderConfiguration(p *ProviderConfiguration) {
-	if len(p.OfflineFlagSourcePath) > 0 && p.Resolver == inProcess {
+	if p.hasOfflineFlagSource() && p.Resolver == inProcess {
 		p.Resolver = file
 	}
 
@@ -143,13 +166,41 @@ func configureProviderConfiguration(p *ProviderConfiguration) {
 	}
 }
 
+// hasOfflineFlagSource reports whether paths for the file mode are configured
+func (p *ProviderConfiguration) hasOfflineFlagSource() bool {
+	return len(p.OfflineFlagSourcePath) > 0 || len(p.OfflineFlagSourcePaths) > 0
+}
+
 func validateProviderConfiguration(p *ProviderConfiguration) error {
 	// We need a file path for file mode
-	if len(p.OfflineFlagSourcePath) == 0 && p.Resolver == file {
+	if !p.hasOfflineFlagSource() && p.Resolver == file {
 		return errors.New("resolver Type 'file' requires a OfflineFlagSourcePath")
 	}
 
-	return nil
+	// A client certificate requires its key & vice versa
+	if (p.ClientCertPath == "") != (p.ClientKeyPath == "") {
+		return errors.New("a client certificate requires both a ClientCertPath and a ClientKeyPath")
+	}
+
+	for name, operator := range p.CustomOperators {
+		if name == "" || operator == nil {
+			return errors.New("a custom operator requires a name and an implementation")
+		}
+	}
+
+	return process.CheckCustomOperators(p.customOperatorFuncs())
+}
+
+// customOperatorFuncs returns the custom operators as the in-process service takes them
+func (p *ProviderConfiguration) customOperatorFuncs() map[string]func(values, data any) any {
+	if len(p.CustomOperators) == 0 {
+		return nil
+	}
+	operators := make(map[string]func(values, data any) any, len(p.CustomOperators))
+	for name, operator := range p.CustomOperators {
+		operators[name] = operator
+	}
+	return operators
 }
 
 // updateFromEnvVar is a utility to update configurations based on current environment variables
@@ -169,6 +220,14 @@ func (cfg *ProviderConfiguration) updateFromEnvVar() {
 		cfg.CertPath = certificatePath
 	}
 
+	clientCertPath := os.Getenv(flagdClientCertPathEnvironmentVariableName)
+	clientKeyPath := os.Getenv(flagdClientKeyPathEnvironmentVariableName)
+	if clientCertPath != "" || clientKeyPath != "" {
+		cfg.Tls = true
+		cfg.ClientCertPath = clientCertPath
+		cfg.ClientKeyPath = clientKeyPath
+	}
+
 	cfg.MaxCacheSize = getIntFromEnvVarOrDefault(flagdMaxCacheSizeEnvironmentVariableName, defaultMaxCacheSize, cfg.log)
 
 	if cacheValue := os.Getenv(flagdCacheEnvironmentVariableName); cacheValue != "" {
@@ -203,7 +262,12 @@ func (cfg *ProviderConfiguration) updateFromEnvVar() {
 	}
 
 	if offlinePath := os.Getenv(flagdOfflinePathEnvironmentVariableName); offlinePath != "" {
-		cfg.OfflineFlagSourcePath = offlinePath
+		// multiple paths are separated like in PATH, e.g. by ':' on unix
+		if offlinePaths := filepath.SplitList(offlinePath); len(offlinePaths) > 1 {
+			cfg.OfflineFlagSourcePaths = offlinePaths
+		} else {
+			cfg.OfflineFlagSourcePath = offlinePath
+		}
 	}
 
 	if providerId := os.Getenv(flagdSourceProviderIDEnvironmentVariableName); providerId != "" {
@@ -312,6 +376,50 @@ func WithCertificatePath(path string) ProviderOption {
 	}
 }
 
+// WithClientCertificate specifies the PEM encoded client certificate and key presented to flagd for mutual TLS, with both
+// the rpc & the in-process resolver. The files are checked on each new connection, so rotated certificates are used
+// without restarting the provider.
+func WithClientCertificate(certPath string, keyPath string) ProviderOption {
+	return func(p *ProviderConfiguration) {
+		p.ClientCertPath = certPath
+		p.ClientKeyPath = keyPath
+		p.Tls = true
+	}
+}
+
+// WithTokenSource authenticates the requests to flagd with the bearer token provided by the token source, e.g. when flagd
+// runs behind an authenticating gateway. The token source is called for each evaluation and event stream with the rpc
+// resolver, and for each sync stream with the in-process resolver.
+func WithTokenSource(tokenSource TokenSource) ProviderOption {
+	return func(p *ProviderConfiguration) {
+		p.TokenSource = tokenSource
+	}
+}
+
+// WithSnapshotPath persists the flags of each successful sync of the in-process resolver to the file at path, replacing
+// it atomically. If the file exists on initialization, its flags are served until the first sync arrives, with the
+// provider reporting itself as STALE rather than failing to initialize while the flag source is unavailable.
+// This is only useful with inProcess resolver type
+func WithSnapshotPath(path string) ProviderOption {
+	return func(p *ProviderConfiguration) {
+		p.SnapshotPath = path
+	}
+}
+
+// WithCustomOperator registers the operator under the given name, so it can be used in targeting rules like the
+// built-in operators, e.g. `{"ip_in_range": [{"var": "ip"}, "10.0.0.0/8"]}`. The name must not be one of the JSONLogic
+// or flagd operators. Operators are registered process-wide, so NewProvider fails if another provider registered a
+// different operator under the same name.
+// This is only useful with inProcess & file resolver types
+func WithCustomOperator(name string, operator CustomOperator) ProviderOption {
+	return func(p *ProviderConfiguration) {
+		if p.CustomOperators == nil {
+			p.CustomOperators = map[string]CustomOperator{}
+		}
+		p.CustomOperators[name] = operator
+	}
+}
+
 // WithPort specifies the port of the flagd server. Defaults to 8013
 func WithPort(port uint16) ProviderOption {
 	return func(p *ProviderConfiguration) {
@@ -359,6 +467,17 @@ func WithLRUCache(size int) ProviderOption {
 	}
 }
 
+// WithContextCache enables a cache of the results of the RPC resolver by flag key and evaluation context, in addition to
+// the cache of static results. Results are cached for all reasons but errors, and kept for the ttl, or until flagd
+// reports a change of the flag. Once the size limit is reached each new entry replaces the least recently used entry. A
+// ttl of zero or less keeps the results until the flag changes.
+func WithContextCache(size int, ttl time.Duration) ProviderOption {
+	return func(p *ProviderConfiguration) {
+		p.ContextCacheSize = size
+		p.ContextCacheTTL = ttl
+	}
+}
+
 // WithEventStreamConnectionMaxAttempts sets the maximum number of attempts to connect to flagd's event stream.
 // On successful connection the attempts are reset.
 func WithEventStreamConnectionMaxAttempts(i int) ProviderOption {
@@ -403,10 +522,23 @@ func WithInProcessResolver() ProviderOption {
 	}
 }
 
-// WithOfflineFilePath file path to obtain flags used for provider in file mode.
+// WithOfflineFilePath file path to obtain flags used for provider in file mode. The path can also be a directory or a
+// glob, see WithOfflineFilePaths.
 func WithOfflineFilePath(path string) ProviderOption {
 	return func(p *ProviderConfiguration) {
 		p.OfflineFlagSourcePath = path
+		p.OfflineFlagSourcePaths = nil
+	}
+}
+
+// WithOfflineFilePaths paths of files, directories or globs to obtain flags used for provider in file mode. The JSON and
+// YAML files of all paths are merged into a single flag configuration, with files of later paths taking precedence over
+// files of earlier paths, and files of a directory or glob ordered by name. Flags defined in multiple files are logged.
+// The directories of all paths are watched, so added, changed & removed files are picked up.
+func WithOfflineFilePaths(paths ...string) ProviderOption {
+	return func(p *ProviderConfiguration) {
+		p.OfflineFlagSourcePath = ""
+		p.OfflineFlagSourcePaths = paths
 	}
 }
 
@@ -455,7 +587,7 @@ func WithCustomSyncProviderAndUri(customSyncProvider sync.ISync, customSyncProvi
 
 // WithGrpcDialOptionsOverride provides a set of custom grps.DialOption that will fully override the gRPC dial options used by
 // the InProcess resolver with gRPC syncer. All the other provider options that also set dial options (e.g. WithTLS, or WithCertificatePath)
-// will be silently ignored.
+// will be ignored, with a warning for the TLS options. The selector and the token source are still applied.
 // This is only useful with inProcess resolver type
 func WithGrpcDialOptionsOverride(grpcDialOptionsOverride []grpc.DialOption) ProviderOption {
 	return func(p *ProviderConfiguration) {
@@ -1,6 +1,8 @@
 package flagd
 
 import (
+	"path/filepath"
+	"slices"
 	"testing"
 )
 
@@ -64,3 +66,121 @@ func TestValidateProviderConfigurationFileMissingData(t *testing.T) {
 		t.Errorf("Error expected but check succeeded")
 	}
 }
+
+func TestClientCertificateConfiguration(t *testing.T) {
+	t.Run("from environment variables", func(t *testing.T) {
+		t.Setenv(flagdClientCertPathEnvironmentVariableName, "/certs/tls.crt")
+		t.Setenv(flagdClientKeyPathEnvironmentVariableName, "/certs/tls.key")
+
+		providerConfiguration, err := NewProviderConfiguration(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if providerConfiguration.ClientCertPath != "/certs/tls.crt" || providerConfiguration.ClientKeyPath != "/certs/tls.key" {
+			t.Errorf("unexpected client certificate %s with key %s",
+				providerConfiguration.ClientCertPath, providerConfiguration.ClientKeyPath)
+		}
+		if !providerConfiguration.Tls {
+			t.Error("expected a client certificate to enable TLS")
+		}
+	})
+
+	t.Run("option", func(t *testing.T) {
+		providerConfiguration, err := NewProviderConfiguration([]ProviderOption{
+			WithClientCertificate("tls.crt", "tls.key"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if providerConfiguration.ClientCertPath != "tls.crt" || providerConfiguration.ClientKeyPath != "tls.key" ||
+			!providerConfiguration.Tls {
+			t.Errorf("unexpected configuration %+v", providerConfiguration)
+		}
+	})
+
+	t.Run("certificate without key", func(t *testing.T) {
+		if _, err := NewProviderConfiguration([]ProviderOption{WithClientCertificate("tls.crt", "")}); err == nil {
+			t.Error("Error expected but check succeeded")
+		}
+	})
+}
+
+func TestOfflineFilePathsConfiguration(t *testing.T) {
+	t.Run("from environment variable", func(t *testing.T) {
+		t.Setenv(flagdOfflinePathEnvironmentVariableName, "base.json"+string(filepath.ListSeparator)+"overrides")
+
+		providerConfiguration, err := NewProviderConfiguration(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(providerConfiguration.OfflineFlagSourcePaths, []string{"base.json", "overrides"}) ||
+			providerConfiguration.OfflineFlagSourcePath != "" {
+			t.Errorf("unexpected offline flag sources %v", providerConfiguration.OfflineFlagSourcePaths)
+		}
+	})
+
+	t.Run("option", func(t *testing.T) {
+		providerConfiguration, err := NewProviderConfiguration([]ProviderOption{
+			WithInProcessResolver(),
+			WithOfflineFilePath("flags.json"),
+			WithOfflineFilePaths("base.json", "overrides/*.yaml"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(providerConfiguration.OfflineFlagSourcePaths, []string{"base.json", "overrides/*.yaml"}) ||
+			providerConfiguration.OfflineFlagSourcePath != "" {
+			t.Errorf("unexpected configuration %+v", providerConfiguration)
+		}
+		if providerConfiguration.Resolver != file {
+			t.Errorf("incorrect Resolver, expected %v, got %v", file, providerConfiguration.Resolver)
+		}
+	})
+}
+
+func TestCustomOperatorConfiguration(t *testing.T) {
+	operator := func(values, data any) any { return true }
+
+	t.Run("option", func(t *testing.T) {
+		providerConfiguration, err := NewProviderConfiguration([]ProviderOption{
+			WithInProcessResolver(),
+			WithCustomOperator("ip_in_range", operator),
+			WithCustomOperator("geo_within", operator),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(providerConfiguration.CustomOperators) != 2 {
+			t.Errorf("expected 2 custom operators, got %v", providerConfiguration.CustomOperators)
+		}
+	})
+
+	t.Run("invalid operators", func(t *testing.T) {
+		tests := map[string]ProviderOption{
+			"jsonlogic operator": WithCustomOperator("in", operator),
+			"flagd operator":     WithCustomOperator("sem_ver", operator),
+			"empty name":         WithCustomOperator("", operator),
+			"no implementation":  WithCustomOperator("ip_in_range", nil),
+		}
+		for name, option := range tests {
+			t.Run(name, func(t *testing.T) {
+				if _, err := NewProviderConfiguration([]ProviderOption{option}); err == nil {
+					t.Error("Error expected but check succeeded")
+				}
+			})
+		}
+	})
+
+	t.Run("operators of providers must agree", func(t *testing.T) {
+		if _, err := NewProvider(WithInProcessResolver(), WithCustomOperator("agreed", operator)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := NewProvider(WithInProcessResolver(), WithCustomOperator("agreed", operator)); err != nil {
+			t.Errorf("Expected the same operator to be accepted, but got %v", err)
+		}
+		other := func(values, data any) any { return false }
+		if _, err := NewProvider(WithInProcessResolver(), WithCustomOperator("agreed", other)); err == nil {
+			t.Error("Error expected but the provider was created")
+		}
+	})
+}
@@ -22,3 +22,14 @@ type IService interface {
 		evalCtx map[string]interface{}) of.InterfaceResolutionDetail
 	EventChannel() <-chan of.Event
 }
+
+// IBulkService is implemented by services evaluating flags remotely, which can evaluate multiple flags with a single call
+type IBulkService interface {
+	ResolveAll(ctx context.Context, flagKeys []string, evalCtx map[string]interface{}) (context.Context, error)
+}
+
+// ISnapshotService is implemented by services which can serve the flags of a last-known-good snapshot until the flag
+// source is available
+type ISnapshotService interface {
+	LoadedSnapshot() bool
+}
@@ -34,6 +34,11 @@ func NewProvider(opts ...ProviderOption) (*Provider, error) {
 		return nil, err
 	}
 
+	// the JSONLogic engine holds operators process-wide, so the operators of all providers must agree
+	if err := process.RegisterCustomOperators(providerConfiguration.customOperatorFuncs()); err != nil {
+		return nil, err
+	}
+
 	provider := &Provider{
 		initialized:           false,
 		eventStream:           make(chan of.Event),
@@ -51,14 +56,19 @@ func NewProvider(opts ...ProviderOption) (*Provider, error) {
 	case rpc:
 		service = rpcService.NewService(
 			rpcService.Configuration{
-				Host:            provider.providerConfiguration.Host,
-				Port:            provider.providerConfiguration.Port,
-				CertificatePath: provider.providerConfiguration.CertPath,
-				SocketPath:      provider.providerConfiguration.SocketPath,
-				TLSEnabled:      provider.providerConfiguration.Tls,
-				OtelInterceptor: provider.providerConfiguration.OtelIntercept,
-				DeadlineMs:      provider.providerConfiguration.DeadlineMs,
-				Selector:        provider.providerConfiguration.Selector,
+				Host:                  provider.providerConfiguration.Host,
+				Port:                  provider.providerConfiguration.Port,
+				CertificatePath:       provider.providerConfiguration.CertPath,
+				SocketPath:            provider.providerConfiguration.SocketPath,
+				TLSEnabled:            provider.providerConfiguration.Tls,
+				OtelInterceptor:       provider.providerConfiguration.OtelIntercept,
+				DeadlineMs:            provider.providerConfiguration.DeadlineMs,
+				Selector:              provider.providerConfiguration.Selector,
+				ContextCacheSize:      provider.providerConfiguration.ContextCacheSize,
+				ClientCertificatePath: provider.providerConfiguration.ClientCertPath,
+				ClientKeyPath:         provider.providerConfiguration.ClientKeyPath,
+				TokenSource:           provider.providerConfiguration.TokenSource,
+				ContextCacheTTL:       provider.providerConfiguration.ContextCacheTTL,
 			},
 			cacheService,
 			provider.providerConfiguration.log,
@@ -72,7 +82,13 @@ func NewProvider(opts ...ProviderOption) (*Provider, error) {
 			TargetUri:               provider.providerConfiguration.TargetUri,
 			TLSEnabled:              provider.providerConfiguration.Tls,
 			CertificatePath:         provider.providerConfiguration.CertPath,
+			ClientCertificatePath:   provider.providerConfiguration.ClientCertPath,
+			ClientKeyPath:           provider.providerConfiguration.ClientKeyPath,
+			TokenSource:             provider.providerConfiguration.TokenSource,
+			SnapshotPath:            provider.providerConfiguration.SnapshotPath,
+			CustomOperators:         provider.providerConfiguration.customOperatorFuncs(),
 			OfflineFlagSource:       provider.providerConfiguration.OfflineFlagSourcePath,
+			OfflineFlagSources:      provider.providerConfiguration.OfflineFlagSourcePaths,
 			CustomSyncProvider:      provider.providerConfiguration.CustomSyncProvider,
 			CustomSyncProviderUri:   provider.providerConfiguration.CustomSyncProviderUri,
 			GrpcDialOptionsOverride: provider.providerConfiguration.GrpcDialOptionsOverride,
@@ -84,8 +100,10 @@ func NewProvider(opts ...ProviderOption) (*Provider, error) {
 		})
 	default:
 		service = process.NewInProcessService(process.Configuration{
-			OfflineFlagSource: provider.providerConfiguration.OfflineFlagSourcePath,
-			DeadlineMs:        provider.providerConfiguration.DeadlineMs,
+			OfflineFlagSource:  provider.providerConfiguration.OfflineFlagSourcePath,
+			OfflineFlagSources: provider.providerConfiguration.OfflineFlagSourcePaths,
+			CustomOperators:    provider.providerConfiguration.customOperatorFuncs(),
+			DeadlineMs:         provider.providerConfiguration.DeadlineMs,
 		})
 	}
 
@@ -136,6 +154,16 @@ func (p *Provider) Init(_ of.EvaluationContext) error {
 				go p.handleEvents()
 				return nil
 			}
+			// A service serving the flags of a snapshot is initialized, but stale until the flag source is available
+			if e.EventType == of.ProviderStale && p.loadedSnapshot() {
+				p.status = of.StaleState
+				p.initialized = true
+				go func() {
+					p.eventStream <- e
+					p.handleEvents()
+				}()
+				return nil
+			}
 			// If we got a ProviderError or ProviderStale during init, return it as an error
 			if e.EventType == of.ProviderError || e.EventType == of.ProviderStale {
 				return fmt.Errorf("provider initialization failed: %s", e.ProviderEventDetails.Message)
@@ -222,6 +250,34 @@ func (p *Provider) ObjectEvaluation(
 	return p.service.ResolveObject(ctx, flagKey, defaultValue, evalCtx)
 }
 
+// ResolveAll evaluates the flags with the given keys, or all flags if no keys are given, for the evaluation context
+// with a single call to flagd. Evaluations using the returned context are served from these results, as long as they
+// are made for the same evaluation context, including any context merged by the OpenFeature API & client. Static results
+// are also added to the cache. With the in-process resolver flags are always evaluated locally, so the context is
+// returned as is.
+func (p *Provider) ResolveAll(ctx context.Context, evalCtx of.EvaluationContext, flagKeys ...string) (context.Context, error) {
+	bulkService, ok := p.service.(IBulkService)
+	if !ok {
+		return ctx, nil
+	}
+	return bulkService.ResolveAll(ctx, flagKeys, flattenContext(evalCtx))
+}
+
+// flattenContext flattens the evaluation context the same way the OpenFeature client does before calling the provider
+func flattenContext(evalCtx of.EvaluationContext) of.FlattenedContext {
+	flatCtx := evalCtx.Attributes()
+	if targetingKey := evalCtx.TargetingKey(); targetingKey != "" {
+		flatCtx[of.TargetingKey] = targetingKey
+	}
+	return flatCtx
+}
+
+// loadedSnapshot reports whether the service loaded the flags of a last-known-good snapshot
+func (p *Provider) loadedSnapshot() bool {
+	snapshotService, ok := p.service.(ISnapshotService)
+	return ok && snapshotService.LoadedSnapshot()
+}
+
 func (p *Provider) setStatus(status of.State) {
 	p.mtx.Lock()
 	defer p.mtx.Unlock()
@@ -1,6 +1,7 @@
 package flagd
 
 import (
+	"context"
 	"reflect"
 	"testing"
 	"time"
@@ -630,6 +631,103 @@ func TestInitProviderStaleEvent(t *testing.T) {
 	}
 }
 
+func TestInitFromSnapshot(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventChan := make(chan of.Event)
+
+	provider, err := NewProvider()
+	if err != nil {
+		t.Fatal("error creating new provider", err)
+	}
+
+	svcMock := struct {
+		*mock.MockIService
+		*mock.MockISnapshotService
+	}{mock.NewMockIService(ctrl), mock.NewMockISnapshotService(ctrl)}
+	provider.service = svcMock
+	// Serve a snapshot: stale on init, ready once the first sync arrives
+	svcMock.MockIService.EXPECT().Init().DoAndReturn(func() error {
+		go func() {
+			eventChan <- of.Event{
+				ProviderName:         "flagd",
+				EventType:            of.ProviderStale,
+				ProviderEventDetails: of.ProviderEventDetails{Message: "serving flags of snapshot"},
+			}
+		}()
+		return nil
+	}).Times(1)
+	svcMock.MockIService.EXPECT().EventChannel().Return(eventChan).AnyTimes()
+	svcMock.MockISnapshotService.EXPECT().LoadedSnapshot().Return(true).Times(1)
+
+	// when
+	err = provider.Init(of.EvaluationContext{})
+
+	// then
+	if err != nil {
+		t.Fatalf("expected no error when serving a snapshot, got: %v", err)
+	}
+	if provider.Status() != of.StaleState {
+		t.Errorf("expected provider to be %s, got %s", of.StaleState, provider.Status())
+	}
+	if event := <-provider.EventChannel(); event.EventType != of.ProviderStale {
+		t.Errorf("expected the %s event to be forwarded, got %s", of.ProviderStale, event.EventType)
+	}
+
+	eventChan <- of.Event{ProviderName: "flagd", EventType: of.ProviderReady}
+	<-provider.EventChannel()
+	// the status is updated after the event is forwarded
+	for deadline := time.Now().Add(time.Second); provider.Status() != of.ReadyState && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if provider.Status() != of.ReadyState {
+		t.Errorf("expected provider to be %s after the first sync, got %s", of.ReadyState, provider.Status())
+	}
+}
+
+func TestStaleEventAfterInit(t *testing.T) {
+	// given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventChan := make(chan of.Event)
+
+	provider, err := NewProvider()
+	if err != nil {
+		t.Fatal("error creating new provider", err)
+	}
+
+	svcMock := mock.NewMockIService(ctrl)
+	provider.service = svcMock
+	svcMock.EXPECT().Init().DoAndReturn(func() error {
+		go func() {
+			eventChan <- of.Event{ProviderName: "flagd", EventType: of.ProviderReady}
+		}()
+		return nil
+	}).Times(1)
+	svcMock.EXPECT().EventChannel().Return(eventChan).AnyTimes()
+
+	if err := provider.Init(of.EvaluationContext{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// when the stale timer reports a lost connection
+	eventChan <- of.Event{ProviderName: "flagd", EventType: of.ProviderStale}
+	if event := <-provider.EventChannel(); event.EventType != of.ProviderStale {
+		t.Errorf("expected the %s event to be forwarded, got %s", of.ProviderStale, event.EventType)
+	}
+	// the next event is only received once the stale event is handled
+	eventChan <- of.Event{ProviderName: "flagd", EventType: of.ProviderStale}
+	<-provider.EventChannel()
+
+	// then the status is only set to stale for the snapshot served on initialization
+	if provider.Status() != of.ReadyState {
+		t.Errorf("expected provider to stay %s, got %s", of.ReadyState, provider.Status())
+	}
+}
+
 func TestInitWithCustomDeadline(t *testing.T) {
 	// given
 	ctrl := gomock.NewController(t)
@@ -719,3 +817,76 @@ func TestHandleEventsChannelClose(t *testing.T) {
 	// Clean up to avoid affecting other tests
 	provider.Shutdown()
 }
+
+func TestResolveAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	provider, err := NewProvider()
+	if err != nil {
+		t.Fatal("error creating new provider", err)
+	}
+
+	// in-process services evaluate locally, the context is returned as is
+	provider.service = mock.NewMockIService(ctrl)
+	ctx := context.Background()
+	resultCtx, err := provider.ResolveAll(ctx, of.NewEvaluationContext("user", nil), "flag")
+	if err != nil || resultCtx != ctx {
+		t.Errorf("expected the context to be returned as is, got %v, %v", resultCtx, err)
+	}
+
+	bulkMock := mock.NewMockIBulkService(ctrl)
+	provider.service = struct {
+		IService
+		IBulkService
+	}{mock.NewMockIService(ctrl), bulkMock}
+	type key struct{}
+	prefetchedCtx := context.WithValue(ctx, key{}, true)
+	bulkMock.EXPECT().
+		ResolveAll(ctx, []string{"flag"}, map[string]interface{}{of.TargetingKey: "user", "email": "user@example.com"}).
+		Return(prefetchedCtx, nil)
+
+	evalCtx := of.NewEvaluationContext("user", map[string]interface{}{"email": "user@example.com"})
+	resultCtx, err = provider.ResolveAll(ctx, evalCtx, "flag")
+	if err != nil || resultCtx != prefetchedCtx {
+		t.Errorf("expected the context of the bulk service, got %v, %v", resultCtx, err)
+	}
+}
+
+func TestInMemorySyncProvider(t *testing.T) {
+	// given
+	syncProvider := process.NewInMemorySync("memory://flags")
+	err := syncProvider.SetFlags(`{"flags": {"flag": {"state": "ENABLED", "variants": {"on": true, "off": false}, "defaultVariant": "on"}}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	provider, err := NewProvider(WithInProcessResolver(), WithCustomSyncProviderAndUri(syncProvider, "memory://flags"))
+	if err != nil {
+		t.Fatal("error creating new provider", err)
+	}
+
+	// when
+	if err := provider.Init(of.EvaluationContext{}); err != nil {
+		t.Fatal(err)
+	}
+	defer provider.Shutdown()
+
+	// then
+	if detail := provider.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{}); !detail.Value {
+		t.Errorf("expected true, got %v", detail)
+	}
+
+	if err := syncProvider.SetFlag("disabled", `{"state": "DISABLED", "variants": {"on": true}, "defaultVariant": "on"}`); err != nil {
+		t.Fatal(err)
+	}
+	for event := range provider.EventChannel() {
+		if event.EventType == of.ProviderConfigChange && reflect.DeepEqual(event.FlagChanges, []string{"disabled"}) {
+			break
+		}
+	}
+	if detail := provider.BooleanEvaluation(context.Background(), "disabled", false, of.FlattenedContext{}); detail.Value ||
+		detail.Reason != of.DisabledReason {
+		t.Errorf("expected the disabled flag to return the default value, got %v", detail)
+	}
+}
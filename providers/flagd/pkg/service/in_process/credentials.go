@@ -0,0 +1,64 @@
+package process
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	grpccredential "github.com/open-feature/flagd/core/pkg/sync/grpc/credentials"
+	"github.com/open-feature/go-sdk-contrib/providers/flagd/internal/clientcert"
+	"google.golang.org/grpc/credentials"
+)
+
+// newCredentialBuilder returns the flagd credential builder, or one presenting the client certificate if configured
+func newCredentialBuilder(cfg Configuration) grpccredential.Builder {
+	if cfg.ClientCertificatePath == "" && cfg.ClientKeyPath == "" {
+		return &grpccredential.CredentialBuilder{}
+	}
+	return &clientCertificateCredentialBuilder{
+		certPath: cfg.ClientCertificatePath,
+		keyPath:  cfg.ClientKeyPath,
+	}
+}
+
+// clientCertificateCredentialBuilder builds the transport credentials of the gRPC sync like the flagd credential builder,
+// presenting a client certificate for mutual TLS in addition
+type clientCertificateCredentialBuilder struct {
+	certPath string
+	keyPath  string
+}
+
+// Build builds TLS credentials trusting the certificate at certPath, or the system CAs if empty, and presenting the
+// client certificate. The client certificate is reloaded on new connections once its files are rotated.
+func (cb *clientCertificateCredentialBuilder) Build(secure bool, certPath string) (credentials.TransportCredentials, error) {
+	if !secure {
+		return nil, fmt.Errorf("provided a client certificate %s, but requested an insecure connection."+
+			" Please check configurations of the grpc sync source", cb.certPath)
+	}
+
+	keyPair, err := clientcert.NewKeyPair(cb.certPath, cb.keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:           tls.VersionTLS12,
+		GetClientCertificate: keyPair.GetClientCertificate,
+	}
+
+	if certPath != "" {
+		certBytes, err := os.ReadFile(certPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read file %s: %w", certPath, err)
+		}
+
+		cp := x509.NewCertPool()
+		if !cp.AppendCertsFromPEM(certBytes) {
+			return nil, fmt.Errorf("invalid certificate provided at path: %s", certPath)
+		}
+		tlsConfig.RootCAs = cp
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
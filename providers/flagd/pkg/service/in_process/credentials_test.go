@@ -0,0 +1,25 @@
+package process
+
+import (
+	"testing"
+
+	grpccredential "github.com/open-feature/flagd/core/pkg/sync/grpc/credentials"
+)
+
+func TestNewCredentialBuilder(t *testing.T) {
+	if _, ok := newCredentialBuilder(Configuration{}).(*grpccredential.CredentialBuilder); !ok {
+		t.Error("expected the flagd credential builder without client certificate")
+	}
+
+	builder := newCredentialBuilder(Configuration{ClientCertificatePath: "tls.crt", ClientKeyPath: "tls.key"})
+	if _, ok := builder.(*clientCertificateCredentialBuilder); !ok {
+		t.Fatalf("expected the client certificate credential builder, got %T", builder)
+	}
+
+	if _, err := builder.Build(false, ""); err == nil {
+		t.Error("expected an error for a client certificate on an insecure connection")
+	}
+	if _, err := builder.Build(true, ""); err == nil {
+		t.Error("expected an error for missing client certificate files")
+	}
+}
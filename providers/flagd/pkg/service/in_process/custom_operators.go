@@ -0,0 +1,81 @@
+package process
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/diegoholiveira/jsonlogic/v3"
+	"github.com/open-feature/flagd/core/pkg/evaluator"
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// flagdOperators are the operators the evaluator adds to the JSONLogic engine
+var flagdOperators = []string{
+	evaluator.FractionEvaluationName, evaluator.StartsWithEvaluationName, evaluator.EndsWithEvaluationName,
+	evaluator.SemVerEvaluationName,
+}
+
+var (
+	customOperatorsMu sync.Mutex
+	// customOperators are the custom operators registered with the JSONLogic engine, keyed by name
+	customOperators = map[string]func(values, data any) any{}
+)
+
+// CheckCustomOperators checks that the operators can be registered, i.e. that none of them replaces a JSONLogic or
+// flagd operator, or an operator registered under the same name with a different function.
+func CheckCustomOperators(operators map[string]func(values, data any) any) error {
+	customOperatorsMu.Lock()
+	defer customOperatorsMu.Unlock()
+
+	return checkCustomOperators(operators)
+}
+
+// RegisterCustomOperators registers the operators with the JSONLogic engine. The engine holds operators process-wide,
+// so no operator is registered if one of them fails the checks of CheckCustomOperators.
+func RegisterCustomOperators(operators map[string]func(values, data any) any) error {
+	customOperatorsMu.Lock()
+	defer customOperatorsMu.Unlock()
+
+	if err := checkCustomOperators(operators); err != nil {
+		return err
+	}
+	for name, operator := range operators {
+		customOperators[name] = operator
+		jsonlogic.AddOperator(name, operator)
+	}
+	return nil
+}
+
+func checkCustomOperators(operators map[string]func(values, data any) any) error {
+	for name, operator := range operators {
+		if registered, ok := customOperators[name]; ok {
+			if reflect.ValueOf(registered).Pointer() != reflect.ValueOf(operator).Pointer() {
+				return fmt.Errorf("custom operator '%s' is already registered with a different implementation", name)
+			}
+			continue
+		}
+		if isBuiltInOperator(name) {
+			return fmt.Errorf("custom operator '%s' conflicts with a built-in operator", name)
+		}
+	}
+	return nil
+}
+
+// isBuiltInOperator reports whether the JSONLogic engine knows the operator without it being a custom operator. The
+// engine does not expose its operators, but validates rules against them.
+func isBuiltInOperator(name string) bool {
+	return slices.Contains(flagdOperators, name) || jsonlogic.ValidateJsonLogic(map[string]any{name: []any{}})
+}
+
+// withCustomOperators registers the custom operators with the JSONLogic engine of the evaluator. Providers register
+// their operators on creation already, so this only registers the operators of services created directly.
+func withCustomOperators(operators map[string]func(values, data any) any, log *logger.Logger) evaluator.JSONEvaluatorOption {
+	return func(_ *evaluator.JSON) {
+		if err := RegisterCustomOperators(operators); err != nil {
+			log.Error("failed to register custom operators", zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package process
+
+import (
+	"context"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+)
+
+var ipRangeFlags = `{
+	"flags": {
+	  "internal": {
+		"state": "ENABLED",
+		"variants": {
+		  "on": true,
+		  "off": false
+		},
+		"defaultVariant": "off",
+		"targeting": {
+		  "if": [{"ip_in_range": [{"var": "ip"}, "10.0.0.0/8"]}, "on"]
+		}
+	  }
+	}
+}`
+
+// ipInRange reports whether the ip of the first argument is within the prefix of the second argument
+func ipInRange(values, _ any) any {
+	args, ok := values.([]any)
+	if !ok || len(args) != 2 {
+		return false
+	}
+	ip, _ := args[0].(string)
+	prefix, _ := args[1].(string)
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	network, err := netip.ParsePrefix(prefix)
+	if err != nil {
+		return false
+	}
+	return network.Contains(addr)
+}
+
+func TestCustomOperators(t *testing.T) {
+	operators := map[string]func(values, data any) any{"ip_in_range": ipInRange}
+
+	offlinePath := filepath.Join(t.TempDir(), "flags.json")
+	if err := os.WriteFile(offlinePath, []byte(ipRangeFlags), 0644); err != nil {
+		t.Fatal(err)
+	}
+	syncProvider := NewInMemorySync("memory")
+	if err := syncProvider.SetFlags(ipRangeFlags); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]Configuration{
+		"file": {OfflineFlagSource: offlinePath, CustomOperators: operators},
+		"sync": {CustomSyncProvider: syncProvider, CustomSyncProviderUri: "memory", CustomOperators: operators},
+	}
+	for name, cfg := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			service := NewInProcessService(cfg)
+			if err := service.Init(); err != nil {
+				t.Fatal(err)
+			}
+			defer service.Shutdown()
+			expectEvent(t, service.EventChannel(), of.ProviderReady)
+
+			// when
+			internal := service.ResolveBoolean(context.Background(), "internal", false,
+				map[string]interface{}{"ip": "10.1.2.3"})
+			external := service.ResolveBoolean(context.Background(), "internal", false,
+				map[string]interface{}{"ip": "192.168.1.1"})
+
+			// then
+			if !internal.Value || internal.Reason != of.TargetingMatchReason {
+				t.Errorf("Expected the targeting match, but got %v", internal)
+			}
+			if external.Value || external.Reason != of.DefaultReason {
+				t.Errorf("Expected the default variant, but got %v", external)
+			}
+		})
+	}
+}
+
+func TestRegisterCustomOperators(t *testing.T) {
+	always := func(_, _ any) any { return true }
+	never := func(_, _ any) any { return false }
+
+	if err := RegisterCustomOperators(map[string]func(values, data any) any{"always": always}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		operators map[string]func(values, data any) any
+		valid     bool
+	}{
+		"same operator again":      {operators: map[string]func(values, data any) any{"always": always}, valid: true},
+		"different operator":       {operators: map[string]func(values, data any) any{"always": never}},
+		"jsonlogic operator":       {operators: map[string]func(values, data any) any{"in": always}},
+		"jsonlogic extra operator": {operators: map[string]func(values, data any) any{"contains_any": always}},
+		"flagd operator":           {operators: map[string]func(values, data any) any{"fractional": always}},
+		"new operator":             {operators: map[string]func(values, data any) any{"never": never}, valid: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := RegisterCustomOperators(test.operators)
+			if test.valid && err != nil {
+				t.Errorf("Expected the operators to be registered, but got %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Error("Error expected but registration succeeded")
+			}
+		})
+	}
+
+	// a rejected registration registers none of the operators
+	err := RegisterCustomOperators(map[string]func(values, data any) any{"sometimes": always, "in": always})
+	if err == nil {
+		t.Fatal("Error expected but registration succeeded")
+	}
+	if err := CheckCustomOperators(map[string]func(values, data any) any{"sometimes": never}); err != nil {
+		t.Errorf("Expected the operator not to be registered, but got %v", err)
+	}
+}
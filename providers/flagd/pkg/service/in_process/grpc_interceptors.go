@@ -5,7 +5,9 @@ import (
 
 	"github.com/open-feature/go-sdk-contrib/providers/flagd/internal/flagdmeta"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 // selectorUnaryInterceptor adds the flagd-selector metadata header to unary gRPC calls
@@ -41,3 +43,49 @@ func selectorStreamInterceptor(selector string) grpc.StreamClientInterceptor {
 		return streamer(ctx, desc, cc, method, opts...)
 	}
 }
+
+// tokenUnaryInterceptor adds the authorization metadata header to unary gRPC calls, calling the token source for each
+// call
+func tokenUnaryInterceptor(tokenSource func(context.Context) (string, error)) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		ctx, err := withToken(ctx, tokenSource)
+		if err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// tokenStreamInterceptor adds the authorization metadata header to streaming gRPC calls, calling the token source for
+// each stream
+func tokenStreamInterceptor(tokenSource func(context.Context) (string, error)) grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		ctx, err := withToken(ctx, tokenSource)
+		if err != nil {
+			return nil, err
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+func withToken(ctx context.Context, tokenSource func(context.Context) (string, error)) (context.Context, error) {
+	token, err := tokenSource(ctx)
+	if err != nil {
+		return ctx, status.Errorf(codes.Unauthenticated, "token source: %v", err)
+	}
+	return metadata.AppendToOutgoingContext(ctx, flagdmeta.Authorization, flagdmeta.BearerToken(token)), nil
+}
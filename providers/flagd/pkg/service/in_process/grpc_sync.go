@@ -43,6 +43,8 @@ type Sync struct {
 	RetryBackOffMs          int
 	RetryBackOffMaxMs       int
 	FatalStatusCodes        []string
+	// TokenSource provides the bearer token sent with each call & stream, if set
+	TokenSource func(context.Context) (string, error)
 
 	// Runtime state
 	client           FlagSyncServiceClient
@@ -89,9 +91,19 @@ func (g *Sync) createConnection() (*grpc.ClientConn, error) {
 			grpc.WithChainStreamInterceptor(selectorStreamInterceptor(g.Selector)),
 		)
 	}
+	if g.TokenSource != nil {
+		grpcInterceptorDialOptions = append(grpcInterceptorDialOptions,
+			grpc.WithChainUnaryInterceptor(tokenUnaryInterceptor(g.TokenSource)),
+			grpc.WithChainStreamInterceptor(tokenStreamInterceptor(g.TokenSource)),
+		)
+	}
 
 	if len(g.GrpcDialOptionsOverride) > 0 {
 		g.Logger.Debug("using provided gRPC DialOptions override")
+		if g.Secure || g.CertPath != "" {
+			g.Logger.Warn("the gRPC DialOptions override replaces the TLS configuration, " +
+				"transport credentials must be provided by the override")
+		}
 		dialOptions := make([]grpc.DialOption, 0, len(g.GrpcDialOptionsOverride)+len(grpcInterceptorDialOptions))
 		dialOptions = append(dialOptions, g.GrpcDialOptionsOverride...)
 		dialOptions = append(dialOptions, grpcInterceptorDialOptions...)
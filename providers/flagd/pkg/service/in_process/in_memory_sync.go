@@ -0,0 +1,251 @@
+package process
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	msync "sync"
+
+	"github.com/open-feature/flagd/core/pkg/sync"
+	of "github.com/open-feature/go-sdk/openfeature"
+)
+
+// InMemorySync is a programmable implementation of sync.ISync for tests of the in-process resolver without a flag source,
+// to be used with a custom sync provider. Flags set, updated or deleted from the test are sent as a new flag
+// configuration, and sync errors & disconnects of the flag source can be simulated.
+//
+// Once connected, each change sends the whole flag configuration, like a flagd sync stream. Changes made while
+// disconnected are sent on reconnection.
+type InMemorySync struct {
+	uri string
+
+	mu           msync.Mutex
+	flags        map[string]json.RawMessage
+	properties   map[string]json.RawMessage
+	pending      []sync.DataSync
+	disconnected bool
+	initErr      error
+	syncing      bool
+
+	notify chan struct{}
+	events chan SyncEvent
+}
+
+var (
+	_ sync.ISync = (*InMemorySync)(nil)
+	_ EventSync  = (*InMemorySync)(nil)
+)
+
+// NewInMemorySync returns an InMemorySync without flags, sending its flag configurations as the given source. The uri
+// should be the one of the custom sync provider.
+func NewInMemorySync(uri string) *InMemorySync {
+	return &InMemorySync{
+		uri:        uri,
+		flags:      map[string]json.RawMessage{},
+		properties: map[string]json.RawMessage{},
+		notify:     make(chan struct{}, 1),
+		events:     make(chan SyncEvent, 10),
+	}
+}
+
+// Init returns the error set by SetInitError, if any
+func (s *InMemorySync) Init(context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.initErr
+}
+
+// IsReady reports whether the InMemorySync is connected
+func (s *InMemorySync) IsReady() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return !s.disconnected
+}
+
+// Sync sends the current flag configuration, unless disconnected, and each change until the context is done
+func (s *InMemorySync) Sync(ctx context.Context, dataSync chan<- sync.DataSync) error {
+	s.mu.Lock()
+	s.syncing = true
+	s.pending = nil
+	if !s.disconnected {
+		s.pending = append(s.pending, s.dataSyncLocked())
+	}
+	s.mu.Unlock()
+
+	for {
+		for _, data := range s.takePending() {
+			select {
+			case dataSync <- data:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+
+		select {
+		case <-s.notify:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// ReSync sends the current flag configuration
+func (s *InMemorySync) ReSync(ctx context.Context, dataSync chan<- sync.DataSync) error {
+	s.mu.Lock()
+	data := s.dataSyncLocked()
+	s.mu.Unlock()
+
+	select {
+	case dataSync <- data:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Events returns the connection events of the InMemorySync
+func (s *InMemorySync) Events() chan SyncEvent {
+	return s.events
+}
+
+// SetFlags replaces all flags with the ones of the flag configuration, e.g. `{"flags": {...}, "$evaluators": {...}}`
+func (s *InMemorySync) SetFlags(configuration string) error {
+	var config map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(configuration), &config); err != nil {
+		return fmt.Errorf("invalid flag configuration: %w", err)
+	}
+	flags := map[string]json.RawMessage{}
+	if rawFlags, ok := config["flags"]; ok {
+		if err := json.Unmarshal(rawFlags, &flags); err != nil {
+			return fmt.Errorf("invalid flags: %w", err)
+		}
+	}
+	delete(config, "flags")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.flags = flags
+	s.properties = config
+	s.sendLocked()
+	return nil
+}
+
+// SetFlag adds the flag with the given definition, e.g. `{"state": "ENABLED", ...}`, or replaces it if it exists
+func (s *InMemorySync) SetFlag(key string, definition string) error {
+	if !json.Valid([]byte(definition)) {
+		return fmt.Errorf("invalid definition of flag %s", key)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.flags[key] = json.RawMessage(definition)
+	s.sendLocked()
+	return nil
+}
+
+// DeleteFlag removes the flag
+func (s *InMemorySync) DeleteFlag(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.flags, key)
+	s.sendLocked()
+}
+
+// SendInvalid sends the data as flag configuration as is, simulating a sync error if it is not a valid configuration.
+// The flags are not changed. Like changes of the flags, the data is only sent while connected and once the sync has
+// started, otherwise it is discarded.
+func (s *InMemorySync) SendInvalid(data string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.disconnected {
+		return
+	}
+	s.queueLocked(sync.DataSync{FlagData: data, Source: s.uri})
+}
+
+// Disconnect simulates a lost connection to the flag source, reported as a provider error event. No flag configurations
+// are sent until Reconnect is called.
+func (s *InMemorySync) Disconnect() {
+	s.mu.Lock()
+	if s.disconnected {
+		s.mu.Unlock()
+		return
+	}
+	s.disconnected = true
+	s.pending = nil
+	s.mu.Unlock()
+
+	s.sendEvent(SyncEvent{event: of.ProviderError})
+}
+
+// Reconnect simulates a restored connection to the flag source, reported as a provider ready event, and sends the
+// current flag configuration
+func (s *InMemorySync) Reconnect() {
+	s.mu.Lock()
+	if !s.disconnected {
+		s.mu.Unlock()
+		return
+	}
+	s.disconnected = false
+	s.mu.Unlock()
+
+	s.sendEvent(SyncEvent{event: of.ProviderReady})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sendLocked()
+}
+
+// SetInitError makes Init fail with the error, simulating a flag source which can not be set up
+func (s *InMemorySync) SetInitError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.initErr = err
+}
+
+// sendEvent sends the connection event without holding the lock. Once the channel is full it blocks until an event is
+// read, as dropping a connection event would leave the service in the wrong state.
+func (s *InMemorySync) sendEvent(event SyncEvent) {
+	s.events <- event
+}
+
+// sendLocked queues the current flag configuration, if connected
+func (s *InMemorySync) sendLocked() {
+	if s.disconnected {
+		return
+	}
+	s.queueLocked(s.dataSyncLocked())
+}
+
+func (s *InMemorySync) queueLocked(data sync.DataSync) {
+	if !s.syncing {
+		// the current flag configuration is sent once the sync starts
+		return
+	}
+	s.pending = append(s.pending, data)
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (s *InMemorySync) takePending() []sync.DataSync {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pending := s.pending
+	s.pending = nil
+	return pending
+}
+
+// dataSyncLocked builds the current flag configuration
+func (s *InMemorySync) dataSyncLocked() sync.DataSync {
+	config := make(map[string]interface{}, len(s.properties)+1)
+	for key, value := range s.properties {
+		config[key] = value
+	}
+	config["flags"] = s.flags
+
+	// marshalling raw messages which were validated can not fail
+	data, _ := json.Marshal(config)
+	return sync.DataSync{FlagData: string(data), Source: s.uri}
+}
@@ -0,0 +1,207 @@
+package process
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+)
+
+var targetingFlag = `{
+	"state": "ENABLED",
+	"variants": {
+	  "on": true,
+	  "off": false
+	},
+	"defaultVariant": "off",
+	"targeting": {
+	  "if": [{"ends_with": [{"var": "email"}, "@example.com"]}, "on"]
+	}
+}`
+
+func TestInMemorySync(t *testing.T) {
+	t.Run("flags & change events", func(t *testing.T) {
+		// given
+		syncProvider := NewInMemorySync("memory")
+		if err := syncProvider.SetFlags(flagRsp); err != nil {
+			t.Fatal(err)
+		}
+		service := NewInProcessService(Configuration{CustomSyncProvider: syncProvider, CustomSyncProviderUri: "memory"})
+
+		// when
+		if err := service.Init(); err != nil {
+			t.Fatal(err)
+		}
+		defer service.Shutdown()
+
+		// then
+		expectEvent(t, service.EventChannel(), of.ProviderReady)
+		expectEvent(t, service.EventChannel(), of.ProviderConfigChange)
+		if detail := service.ResolveBoolean(context.Background(), "myBoolFlag", false, nil); !detail.Value {
+			t.Errorf("Expected true, but got %v", detail)
+		}
+
+		if err := syncProvider.SetFlag("targeted", targetingFlag); err != nil {
+			t.Fatal(err)
+		}
+		changed := expectEvent(t, service.EventChannel(), of.ProviderConfigChange)
+		if len(changed.FlagChanges) != 1 || changed.FlagChanges[0] != "targeted" {
+			t.Errorf("Expected only the added flag to change, but got %v", changed.FlagChanges)
+		}
+		evalCtx := map[string]interface{}{"email": "user@example.com"}
+		if detail := service.ResolveBoolean(context.Background(), "targeted", false, evalCtx); !detail.Value ||
+			detail.Reason != of.TargetingMatchReason {
+			t.Errorf("Expected the targeting match, but got %v", detail)
+		}
+
+		syncProvider.DeleteFlag("myBoolFlag")
+		changed = expectEvent(t, service.EventChannel(), of.ProviderConfigChange)
+		if len(changed.FlagChanges) != 1 || changed.FlagChanges[0] != "myBoolFlag" {
+			t.Errorf("Expected only the deleted flag to change, but got %v", changed.FlagChanges)
+		}
+		detail := service.ResolveBoolean(context.Background(), "myBoolFlag", false, nil)
+		if detail.ResolutionDetail().ErrorCode != of.FlagNotFoundCode {
+			t.Errorf("Expected the deleted flag not to be found, but got %v", detail)
+		}
+
+		if err := syncProvider.SetFlag("invalid", "{"); err == nil {
+			t.Error("Expected an error for an invalid flag definition")
+		}
+	})
+
+	t.Run("sync errors", func(t *testing.T) {
+		// given
+		syncProvider := NewInMemorySync("memory")
+		service := NewInProcessService(Configuration{CustomSyncProvider: syncProvider, CustomSyncProviderUri: "memory"})
+		if err := service.Init(); err != nil {
+			t.Fatal(err)
+		}
+		defer service.Shutdown()
+		expectEvent(t, service.EventChannel(), of.ProviderReady)
+
+		// when
+		syncProvider.SendInvalid("{")
+
+		// then
+		expectEvent(t, service.EventChannel(), of.ProviderError)
+
+		if err := syncProvider.SetFlags(flagRsp); err != nil {
+			t.Fatal(err)
+		}
+		expectEvent(t, service.EventChannel(), of.ProviderReady)
+	})
+
+	t.Run("disconnects", func(t *testing.T) {
+		// given
+		syncProvider := NewInMemorySync("memory")
+		if err := syncProvider.SetFlags(flagRsp); err != nil {
+			t.Fatal(err)
+		}
+		service := NewInProcessService(Configuration{CustomSyncProvider: syncProvider, CustomSyncProviderUri: "memory"})
+		if err := service.Init(); err != nil {
+			t.Fatal(err)
+		}
+		defer service.Shutdown()
+		expectEvent(t, service.EventChannel(), of.ProviderReady)
+		expectEvent(t, service.EventChannel(), of.ProviderConfigChange)
+
+		// when
+		syncProvider.Disconnect()
+
+		// then - stale, and in error once the grace period is over
+		expectEvent(t, service.EventChannel(), of.ProviderStale)
+		expectEvent(t, service.EventChannel(), of.ProviderError)
+		if syncProvider.IsReady() {
+			t.Error("Expected the sync provider not to be ready while disconnected")
+		}
+
+		// flags changed while disconnected are sent on reconnection
+		syncProvider.DeleteFlag("myBoolFlag")
+		syncProvider.Reconnect()
+		expectEvent(t, service.EventChannel(), of.ProviderReady)
+		changed := expectEvent(t, service.EventChannel(), of.ProviderConfigChange)
+		if len(changed.FlagChanges) != 1 || changed.FlagChanges[0] != "myBoolFlag" {
+			t.Errorf("Expected the deleted flag to change, but got %v", changed.FlagChanges)
+		}
+	})
+
+	t.Run("invalid data is not sent while disconnected", func(t *testing.T) {
+		// given
+		syncProvider := NewInMemorySync("memory")
+		if err := syncProvider.SetFlags(flagRsp); err != nil {
+			t.Fatal(err)
+		}
+		service := NewInProcessService(Configuration{CustomSyncProvider: syncProvider, CustomSyncProviderUri: "memory"})
+		if err := service.Init(); err != nil {
+			t.Fatal(err)
+		}
+		defer service.Shutdown()
+		expectEvent(t, service.EventChannel(), of.ProviderReady)
+		expectEvent(t, service.EventChannel(), of.ProviderConfigChange)
+		syncProvider.Disconnect()
+		expectEvent(t, service.EventChannel(), of.ProviderStale)
+		expectEvent(t, service.EventChannel(), of.ProviderError)
+
+		// when
+		syncProvider.SendInvalid("{")
+		syncProvider.Reconnect()
+
+		// then - only the valid flag configuration is sent on reconnection
+		expectEvent(t, service.EventChannel(), of.ProviderReady)
+		timeout := time.After(200 * time.Millisecond)
+		for waiting := true; waiting; {
+			select {
+			case event := <-service.EventChannel():
+				if event.EventType == of.ProviderError {
+					t.Fatalf("Expected the invalid data to be discarded, but got %v", event)
+				}
+			case <-timeout:
+				waiting = false
+			}
+		}
+	})
+
+	t.Run("events are not dropped", func(t *testing.T) {
+		syncProvider := NewInMemorySync("memory")
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			// more events than the channel holds
+			for range 20 {
+				syncProvider.Disconnect()
+				syncProvider.Reconnect()
+			}
+		}()
+
+		for i := range 40 {
+			expected := of.ProviderError
+			if i%2 == 1 {
+				expected = of.ProviderReady
+			}
+			select {
+			case event := <-syncProvider.Events():
+				if event.event != expected {
+					t.Fatalf("Expected event %d to be %s, but got %s", i, expected, event.event)
+				}
+			case <-time.After(2 * time.Second):
+				t.Fatalf("Expected event %d to be sent", i)
+			}
+		}
+		<-done
+		if !syncProvider.IsReady() {
+			t.Error("Expected the sync provider to be reconnected")
+		}
+	})
+
+	t.Run("init error", func(t *testing.T) {
+		syncProvider := NewInMemorySync("memory")
+		syncProvider.SetInitError(errors.New("unavailable"))
+		service := NewInProcessService(Configuration{CustomSyncProvider: syncProvider, CustomSyncProviderUri: "memory"})
+
+		if err := service.Init(); err == nil {
+			t.Error("Expected the init error")
+		}
+	})
+}
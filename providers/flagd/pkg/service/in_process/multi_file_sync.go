@@ -0,0 +1,393 @@
+package process
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	msync "sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/open-feature/flagd/core/pkg/utils"
+)
+
+// multiFileDebounce collects the events of a change touching multiple files, e.g. a mounted ConfigMap being updated
+const multiFileDebounce = 50 * time.Millisecond
+
+// flagFileExtensions are the extensions of the files read from a directory
+var flagFileExtensions = []string{".json", ".yaml", ".yml"}
+
+// multiFileSync syncs the flags of multiple files, globs or directories as a single merged flag configuration. Files
+// of later paths take precedence over files of earlier paths, and files of a directory or glob are ordered by name.
+// Flags and shared evaluators defined in multiple files are reported, with the definition of the file with the highest
+// precedence being used. The directories of all paths are watched, so added, changed and removed files are picked up.
+// The sync fails if none of the paths holds a readable flag file.
+type multiFileSync struct {
+	Paths  []string
+	URI    string
+	Logger *logger.Logger
+
+	watcher *fsnotify.Watcher
+
+	// mergeMu guards the state of the merges, as ReSync merges concurrently with Sync
+	mergeMu msync.Mutex
+	// files holds the last valid content of each file, used while a file can not be parsed, e.g. while being written
+	files map[string]flagFile
+	// merged is the last merged flag configuration, and sent the last one sent
+	merged string
+	sent   string
+	// duplicates holds the duplicate keys reported for the current files, so each is reported once
+	duplicates map[string]struct{}
+
+	mu    msync.RWMutex
+	ready bool
+}
+
+var _ sync.ISync = (*multiFileSync)(nil)
+
+// flagFile is the content of a flag file relevant for merging
+type flagFile struct {
+	Flags      map[string]json.RawMessage `json:"flags"`
+	Evaluators map[string]json.RawMessage `json:"$evaluators"`
+	Metadata   map[string]json.RawMessage `json:"metadata"`
+}
+
+// isMultiFileSource reports whether the offline flag sources require a multiFileSync rather than a single file sync
+func isMultiFileSource(paths []string) bool {
+	if len(paths) != 1 {
+		return true
+	}
+	if isGlob(paths[0]) {
+		return true
+	}
+	info, err := os.Stat(paths[0])
+	return err == nil && info.IsDir()
+}
+
+func isGlob(path string) bool {
+	return strings.ContainsAny(path, "*?[")
+}
+
+func (m *multiFileSync) Init(_ context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("error creating fsnotify watcher: %w", err)
+	}
+	m.watcher = watcher
+	m.files = map[string]flagFile{}
+	m.duplicates = map[string]struct{}{}
+	m.watch()
+	return nil
+}
+
+func (m *multiFileSync) IsReady() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.ready
+}
+
+func (m *multiFileSync) setReady(ready bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ready = ready
+}
+
+func (m *multiFileSync) ReSync(ctx context.Context, dataSync chan<- sync.DataSync) error {
+	data, err := m.merge()
+	if err != nil {
+		return err
+	}
+	return m.send(ctx, dataSync, data)
+}
+
+func (m *multiFileSync) Sync(ctx context.Context, dataSync chan<- sync.DataSync) error {
+	defer m.watcher.Close()
+	m.Logger.Info(fmt.Sprintf("starting sync from %s", m.URI))
+
+	data, err := m.merge()
+	if err != nil {
+		return err
+	}
+	if err := m.send(ctx, dataSync, data); err != nil {
+		return nil
+	}
+	m.setReady(true)
+
+	debounce := time.NewTimer(0)
+	if !debounce.Stop() {
+		<-debounce.C
+	}
+	defer debounce.Stop()
+
+	for {
+		select {
+		case event, ok := <-m.watcher.Events:
+			if !ok {
+				m.setReady(false)
+				return errors.New("filepath notifier closed")
+			}
+			m.Logger.Debug(fmt.Sprintf("filepath event: %s %s", event.Name, event.Op.String()))
+			debounce.Reset(multiFileDebounce)
+
+		case <-debounce.C:
+			// directories matching a glob may have been added
+			m.watch()
+			data, err := m.merge()
+			if err != nil {
+				// keep the flags sent last rather than removing all of them
+				m.Logger.Error(err.Error())
+				continue
+			}
+			if !m.changed(data) {
+				continue
+			}
+			if err := m.send(ctx, dataSync, data); err != nil {
+				return nil
+			}
+
+		case err, ok := <-m.watcher.Errors:
+			if !ok {
+				m.setReady(false)
+				return errors.New("watcher error")
+			}
+			m.Logger.Error(err.Error())
+
+		case <-ctx.Done():
+			m.Logger.Debug("exiting file watcher")
+			return nil
+		}
+	}
+}
+
+func (m *multiFileSync) send(ctx context.Context, dataSync chan<- sync.DataSync, data string) error {
+	select {
+	case dataSync <- sync.DataSync{FlagData: data, Source: m.URI}:
+		m.mergeMu.Lock()
+		defer m.mergeMu.Unlock()
+		m.sent = data
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// changed reports whether the merged flag configuration differs from the one sent last
+func (m *multiFileSync) changed(data string) bool {
+	m.mergeMu.Lock()
+	defer m.mergeMu.Unlock()
+	return data != m.sent
+}
+
+// duplicatesOf returns the duplicate keys of the flag configuration in alphabetical order, if it is the one merged
+// last. Keys of an older flag configuration are no longer known.
+func (m *multiFileSync) duplicatesOf(flagData string) []string {
+	m.mergeMu.Lock()
+	defer m.mergeMu.Unlock()
+	if flagData != m.merged {
+		return nil
+	}
+	return slices.Sorted(maps.Keys(m.duplicates))
+}
+
+// watch watches the directories of all paths, including directories of globs
+func (m *multiFileSync) watch() {
+	dirs := map[string]struct{}{}
+	for _, path := range m.Paths {
+		switch info, err := os.Stat(path); {
+		case isGlob(path):
+			dirs[globBase(path)] = struct{}{}
+			matches, _ := filepath.Glob(path)
+			for _, match := range matches {
+				dirs[filepath.Dir(match)] = struct{}{}
+			}
+		case err == nil && info.IsDir():
+			dirs[path] = struct{}{}
+		default:
+			// files are replaced rather than written by many tools, so their directory is watched
+			dirs[filepath.Dir(path)] = struct{}{}
+		}
+	}
+
+	for dir := range dirs {
+		if slices.Contains(m.watcher.WatchList(), dir) {
+			continue
+		}
+		if err := m.watcher.Add(dir); err != nil {
+			m.Logger.Warn(fmt.Sprintf("unable to watch %s: %s", dir, err.Error()))
+		}
+	}
+}
+
+// globBase returns the longest leading directory of the glob without pattern characters
+func globBase(pattern string) string {
+	dir := filepath.Dir(pattern)
+	for isGlob(dir) {
+		dir = filepath.Dir(dir)
+	}
+	return dir
+}
+
+// resolve returns the files of all paths in the order of their precedence, lowest first. Each file is listed once, at
+// the position of the first path it matches.
+func (m *multiFileSync) resolve() []string {
+	var files []string
+	seen := map[string]struct{}{}
+	add := func(file string) {
+		if _, ok := seen[file]; !ok {
+			seen[file] = struct{}{}
+			files = append(files, file)
+		}
+	}
+
+	for _, path := range m.Paths {
+		if isGlob(path) {
+			matches, err := filepath.Glob(path)
+			if err != nil {
+				m.Logger.Error(fmt.Sprintf("invalid glob %s: %s", path, err.Error()))
+			}
+			for _, match := range matches {
+				if info, err := os.Stat(match); err == nil && !info.IsDir() {
+					add(match)
+				}
+			}
+			continue
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			m.Logger.Warn(fmt.Sprintf("flag source %s not found: %s", path, err.Error()))
+			continue
+		}
+		if !info.IsDir() {
+			add(path)
+			continue
+		}
+
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			m.Logger.Error(fmt.Sprintf("error reading directory %s: %s", path, err.Error()))
+			continue
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() && slices.Contains(flagFileExtensions, strings.ToLower(filepath.Ext(entry.Name()))) {
+				add(filepath.Join(path, entry.Name()))
+			}
+		}
+	}
+	return files
+}
+
+// read reads the file, falling back to its last valid content if it can not be parsed
+func (m *multiFileSync) read(file string) (flagFile, bool) {
+	content, err := m.parse(file)
+	if err != nil {
+		last, ok := m.files[file]
+		if ok {
+			m.Logger.Error(fmt.Sprintf("error reading %s, using its last valid content: %s", file, err.Error()))
+		} else {
+			m.Logger.Error(fmt.Sprintf("error reading %s: %s", file, err.Error()))
+		}
+		return last, ok
+	}
+	m.files[file] = content
+	return content, true
+}
+
+func (m *multiFileSync) parse(file string) (flagFile, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return flagFile{}, fmt.Errorf("error reading file %s: %w", file, err)
+	}
+	if len(data) == 0 {
+		return flagFile{}, fmt.Errorf("file %s is empty", file)
+	}
+	converted, err := utils.ConvertToJSON(data, filepath.Ext(file), "")
+	if err != nil {
+		return flagFile{}, fmt.Errorf("error converting file %s to json: %w", file, err)
+	}
+	var content flagFile
+	if err := json.Unmarshal([]byte(converted), &content); err != nil {
+		return flagFile{}, fmt.Errorf("error parsing file %s: %w", file, err)
+	}
+	return content, nil
+}
+
+// merge merges the flag files into a single flag configuration. It fails if none of the files can be read.
+func (m *multiFileSync) merge() (string, error) {
+	m.mergeMu.Lock()
+	defer m.mergeMu.Unlock()
+
+	merged := flagFile{
+		Flags:      map[string]json.RawMessage{},
+		Evaluators: map[string]json.RawMessage{},
+		Metadata:   map[string]json.RawMessage{},
+	}
+	flagOrigins := map[string]string{}
+	evaluatorOrigins := map[string]string{}
+	duplicates := map[string]struct{}{}
+
+	files := m.resolve()
+	read := 0
+	for _, file := range files {
+		content, ok := m.read(file)
+		if !ok {
+			continue
+		}
+		read++
+		mergeInto(merged.Flags, flagOrigins, duplicates, content.Flags, file, "flag")
+		mergeInto(merged.Evaluators, evaluatorOrigins, duplicates, content.Evaluators, file, "evaluator")
+		for key, value := range content.Metadata {
+			merged.Metadata[key] = value
+		}
+	}
+
+	for duplicate := range duplicates {
+		if _, reported := m.duplicates[duplicate]; !reported {
+			m.Logger.Warn(duplicate)
+		}
+	}
+	m.duplicates = duplicates
+
+	// forget files which were removed
+	for file := range m.files {
+		if !slices.Contains(files, file) {
+			delete(m.files, file)
+		}
+	}
+
+	if read == 0 {
+		return "", fmt.Errorf("no readable flag file found in %s", strings.Join(m.Paths, ", "))
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		// marshalling raw messages which were parsed can not fail
+		return "", fmt.Errorf("error merging flag files: %w", err)
+	}
+	m.merged = string(data)
+	return m.merged, nil
+}
+
+// mergeInto adds the definitions of the file to the merged definitions, collecting a message for each key defined in
+// multiple files
+func mergeInto(
+	merged map[string]json.RawMessage, origins map[string]string, duplicates map[string]struct{},
+	definitions map[string]json.RawMessage, file string, kind string,
+) {
+	for key, definition := range definitions {
+		if origin, ok := origins[key]; ok {
+			duplicates[fmt.Sprintf("duplicate %s key '%s' defined in %s and %s, using the definition of %s",
+				kind, key, origin, file, file)] = struct{}{}
+		}
+		merged[key] = definition
+		origins[key] = file
+	}
+}
@@ -0,0 +1,237 @@
+package process
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	msync "sync"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	of "github.com/open-feature/go-sdk/openfeature"
+	"go.uber.org/zap"
+)
+
+var baseFlags = `{
+	"flags": {
+	  "myBoolFlag": {
+		"state": "ENABLED",
+		"variants": {
+		  "on": true,
+		  "off": false
+		},
+		"defaultVariant": "on"
+	  },
+	  "baseFlag": {
+		"state": "ENABLED",
+		"variants": {
+		  "on": true
+		},
+		"defaultVariant": "on"
+	  }
+	}
+}`
+
+var overrideFlags = `flags:
+  myBoolFlag:
+    state: ENABLED
+    variants:
+      "on": true
+      "off": false
+    defaultVariant: "off"
+`
+
+var addedFlags = `{
+	"flags": {
+	  "addedFlag": {
+		"state": "ENABLED",
+		"variants": {
+		  "on": true
+		},
+		"defaultVariant": "on"
+	  }
+	}
+}`
+
+func writeFlagFile(t *testing.T, path string, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func expectFlagChanges(t *testing.T, events <-chan of.Event, flags ...string) of.Event {
+	t.Helper()
+	changed := expectEvent(t, events, of.ProviderConfigChange)
+	slices.Sort(changed.FlagChanges)
+	slices.Sort(flags)
+	if !slices.Equal(changed.FlagChanges, flags) {
+		t.Errorf("Expected flags %v to change, but got %v", flags, changed.FlagChanges)
+	}
+	return changed
+}
+
+func TestMultiFileSync(t *testing.T) {
+	t.Run("directory", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		writeFlagFile(t, filepath.Join(dir, "a.json"), baseFlags)
+		writeFlagFile(t, filepath.Join(dir, "b.yaml"), overrideFlags)
+		writeFlagFile(t, filepath.Join(dir, "ignored.txt"), "{")
+
+		// when
+		service := NewInProcessService(Configuration{OfflineFlagSource: dir})
+		if err := service.Init(); err != nil {
+			t.Fatal(err)
+		}
+		defer service.Shutdown()
+
+		// then - files are merged, the later file taking precedence
+		expectEvent(t, service.EventChannel(), of.ProviderReady)
+		changed := expectFlagChanges(t, service.EventChannel(), "myBoolFlag", "baseFlag")
+		if !strings.Contains(changed.Message, "duplicate flag key 'myBoolFlag'") {
+			t.Errorf("Expected the duplicate to be reported in the event, but got %s", changed.Message)
+		}
+		if detail := service.ResolveBoolean(context.Background(), "myBoolFlag", true, nil); detail.Value {
+			t.Errorf("Expected the value of the later file, but got %v", detail)
+		}
+		if detail := service.ResolveBoolean(context.Background(), "baseFlag", false, nil); !detail.Value {
+			t.Errorf("Expected the flag of the earlier file, but got %v", detail)
+		}
+
+		// added files are picked up
+		writeFlagFile(t, filepath.Join(dir, "c.json"), addedFlags)
+		expectFlagChanges(t, service.EventChannel(), "addedFlag")
+		if detail := service.ResolveBoolean(context.Background(), "addedFlag", false, nil); !detail.Value {
+			t.Errorf("Expected the flag of the added file, but got %v", detail)
+		}
+
+		// removed files are picked up
+		if err := os.Remove(filepath.Join(dir, "b.yaml")); err != nil {
+			t.Fatal(err)
+		}
+		if changed := expectFlagChanges(t, service.EventChannel(), "myBoolFlag"); changed.Message != "New flag sync" {
+			t.Errorf("Expected no duplicates to be reported, but got %s", changed.Message)
+		}
+		if detail := service.ResolveBoolean(context.Background(), "myBoolFlag", false, nil); !detail.Value {
+			t.Errorf("Expected the value of the remaining file, but got %v", detail)
+		}
+	})
+
+	t.Run("paths & globs", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		overrides := filepath.Join(dir, "a-overrides.yaml")
+		writeFlagFile(t, overrides, overrideFlags)
+		if err := os.Mkdir(filepath.Join(dir, "flags"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		writeFlagFile(t, filepath.Join(dir, "flags", "base.json"), baseFlags)
+
+		// when - the overrides are listed last, so they take precedence despite their name
+		service := NewInProcessService(Configuration{
+			OfflineFlagSources: []string{filepath.Join(dir, "flags", "*.json"), overrides},
+		})
+		if err := service.Init(); err != nil {
+			t.Fatal(err)
+		}
+		defer service.Shutdown()
+
+		// then
+		expectEvent(t, service.EventChannel(), of.ProviderReady)
+		expectFlagChanges(t, service.EventChannel(), "myBoolFlag", "baseFlag")
+		if detail := service.ResolveBoolean(context.Background(), "myBoolFlag", true, nil); detail.Value {
+			t.Errorf("Expected the value of the later path, but got %v", detail)
+		}
+
+		// changed files are picked up
+		writeFlagFile(t, overrides, addedFlags)
+		expectFlagChanges(t, service.EventChannel(), "myBoolFlag", "addedFlag")
+		if detail := service.ResolveBoolean(context.Background(), "myBoolFlag", false, nil); !detail.Value {
+			t.Errorf("Expected the value of the glob, but got %v", detail)
+		}
+	})
+
+	t.Run("duplicates & invalid files", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		first := filepath.Join(dir, "a.json")
+		second := filepath.Join(dir, "b.yaml")
+		writeFlagFile(t, first, baseFlags)
+		writeFlagFile(t, second, overrideFlags)
+		syncProvider := &multiFileSync{Paths: []string{dir}, URI: dir, Logger: logger.NewLogger(zap.NewNop(), false)}
+		if err := syncProvider.Init(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		defer syncProvider.watcher.Close()
+
+		// when
+		merged, err := syncProvider.merge()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// then
+		expected := "duplicate flag key 'myBoolFlag' defined in " + first + " and " + second +
+			", using the definition of " + second
+		if duplicates := syncProvider.duplicatesOf(merged); !slices.Equal(duplicates, []string{expected}) {
+			t.Errorf("Expected the duplicate to be reported, but got %v", duplicates)
+		}
+
+		// the last valid content of a file is used while it can not be parsed
+		writeFlagFile(t, second, "flags: [")
+		if remerged, err := syncProvider.merge(); err != nil || remerged != merged {
+			t.Errorf("Expected the last valid content to be used, but got %s (%v)", remerged, err)
+		}
+
+		// merges may run concurrently, e.g. a ReSync during a change
+		var wg msync.WaitGroup
+		for range 4 {
+			wg.Go(func() {
+				if _, err := syncProvider.merge(); err != nil {
+					t.Error(err)
+				}
+			})
+		}
+		wg.Wait()
+	})
+
+	t.Run("no readable flag file", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFlagFile(t, filepath.Join(dir, "a.json"), "{")
+
+		service := NewInProcessService(Configuration{
+			OfflineFlagSources: []string{dir, filepath.Join(dir, "missing.json")},
+		})
+		if err := service.Init(); err == nil {
+			t.Error("Expected the sync to fail without a readable flag file")
+		}
+		service.Shutdown()
+	})
+}
+
+func TestIsMultiFileSource(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "flags.json")
+	writeFlagFile(t, file, baseFlags)
+
+	tests := map[string]struct {
+		paths    []string
+		expected bool
+	}{
+		"single file":    {paths: []string{file}, expected: false},
+		"missing file":   {paths: []string{filepath.Join(dir, "missing.json")}, expected: false},
+		"directory":      {paths: []string{dir}, expected: true},
+		"glob":           {paths: []string{filepath.Join(dir, "*.json")}, expected: true},
+		"multiple files": {paths: []string{file, file}, expected: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if actual := isMultiFileSource(test.paths); actual != test.expected {
+				t.Errorf("Expected %v, but got %v", test.expected, actual)
+			}
+		})
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
@@ -18,7 +20,6 @@ import (
 	isync "github.com/open-feature/flagd/core/pkg/sync"
 	"github.com/open-feature/flagd/core/pkg/sync/file"
 	"github.com/open-feature/flagd/core/pkg/sync/grpc"
-	"github.com/open-feature/flagd/core/pkg/sync/grpc/credentials"
 	of "github.com/open-feature/go-sdk/openfeature"
 )
 
@@ -38,6 +39,7 @@ type InProcess struct {
 	evaluator       evaluator.IEvaluator
 	flagStore       *store.Store
 	syncProvider    isync.ISync
+	source          string
 	logger          *logger.Logger
 	configuration   Configuration
 	serviceMetadata model.Metadata
@@ -59,6 +61,13 @@ type InProcess struct {
 	readyMu             sync.Mutex
 	ready               bool
 	staleTimer          *staleTimer
+
+	// Last-known-good snapshot
+	snapshotLoaded  atomic.Bool
+	servingSnapshot atomic.Bool
+	// persistedSnapshot is the flag configuration last loaded from or written to the snapshot, only accessed by the
+	// data sync listener once initialized
+	persistedSnapshot string
 }
 
 // shutdownChannels groups all shutdown-related channels
@@ -110,10 +119,16 @@ type Configuration struct {
 	Selector                string
 	TLSEnabled              bool
 	OfflineFlagSource       string
+	OfflineFlagSources      []string
 	CustomSyncProvider      isync.ISync
 	CustomSyncProviderUri   string
 	GrpcDialOptionsOverride []googlegrpc.DialOption
 	CertificatePath         string
+	ClientCertificatePath   string
+	ClientKeyPath           string
+	TokenSource             func(context.Context) (string, error)
+	SnapshotPath            string
+	CustomOperators         map[string]func(values, data any) any
 	RetryGracePeriod        int
 	RetryBackOffMs          int
 	RetryBackOffMaxMs       int
@@ -127,6 +142,12 @@ type EventSync interface {
 	Events() chan SyncEvent
 }
 
+// duplicateReporter is implemented by sync providers merging multiple flag sources, reporting the keys defined more than
+// once in a flag configuration they sent
+type duplicateReporter interface {
+	duplicatesOf(flagData string) []string
+}
+
 // SyncEvent represents an event from the sync provider
 type SyncEvent struct {
 	event of.EventType
@@ -146,9 +167,10 @@ func NewInProcessService(cfg Configuration) *InProcess {
 	flagStore.FlagSources = append(flagStore.FlagSources, uri)
 
 	return &InProcess{
-		evaluator:           evaluator.NewJSON(log, flagStore),
+		evaluator:           evaluator.NewJSON(log, flagStore, withCustomOperators(cfg.CustomOperators, log)),
 		flagStore:           flagStore,
 		syncProvider:        syncProvider,
+		source:              uri,
 		logger:              log,
 		configuration:       cfg,
 		serviceMetadata:     createServiceMetadata(cfg),
@@ -182,11 +204,23 @@ func (i *InProcess) Init() error {
 		return fmt.Errorf("failed to initialize sync provider: %w", err)
 	}
 
+	// Load the last-known-good snapshot before any sync data is processed. The stale event is sent before the sync is
+	// started, so the ready event of a sync arriving right away follows it rather than being overridden by it.
+	loadedSnapshot := i.loadSnapshot()
+	if loadedSnapshot {
+		i.sendSnapshotStale()
+	}
+
 	// Start background processes
 	i.startEventSyncMonitor()
 	i.startDataSyncProcess()
 	i.startDataSyncListener()
 
+	// Serve the snapshot until the first sync arrives rather than waiting for it
+	if loadedSnapshot {
+		return nil
+	}
+
 	// Wait for initialization to complete
 	return i.waitForInitialization()
 }
@@ -345,6 +379,9 @@ func (i *InProcess) processSyncData(data isync.DataSync) {
 		return
 	}
 
+	i.replaceSnapshot(data)
+	i.persistSnapshot(data)
+
 	// Stop stale timer - we've successfully received and processed data
 	i.staleTimer.stop()
 
@@ -376,11 +413,17 @@ func (i *InProcess) processSyncData(data isync.DataSync) {
 
 	// Send config change event if there are changes
 	if len(changedKeys) > 0 {
+		message := "New flag sync"
+		if reporter, ok := i.syncProvider.(duplicateReporter); ok {
+			if duplicates := reporter.duplicatesOf(data.FlagData); len(duplicates) > 0 {
+				message += ": " + strings.Join(duplicates, "; ")
+			}
+		}
 		i.events <- of.Event{
 			ProviderName: providerName,
 			EventType:    of.ProviderConfigChange,
 			ProviderEventDetails: of.ProviderEventDetails{
-				Message:     "New flag sync",
+				Message:     message,
 				FlagChanges: changedKeys,
 			},
 		}
@@ -729,13 +772,23 @@ func createSyncProvider(cfg Configuration, log *logger.Logger) (isync.ISync, str
 		return cfg.CustomSyncProvider, cfg.CustomSyncProviderUri
 	}
 
-	if cfg.OfflineFlagSource != "" {
-		log.Info("using file sync provider with source: " + cfg.OfflineFlagSource)
+	if offlineFlagSources := cfg.offlineFlagSources(); len(offlineFlagSources) > 0 {
+		if isMultiFileSource(offlineFlagSources) {
+			uri := strings.Join(offlineFlagSources, ",")
+			log.Info("using multi file sync provider with sources: " + uri)
+			return &multiFileSync{
+				Paths:  offlineFlagSources,
+				URI:    uri,
+				Logger: log,
+			}, uri
+		}
+
+		log.Info("using file sync provider with source: " + offlineFlagSources[0])
 		return &file.Sync{
-			URI:    cfg.OfflineFlagSource,
+			URI:    offlineFlagSources[0],
 			Logger: log,
 			Mux:    &sync.RWMutex{},
-		}, cfg.OfflineFlagSource
+		}, offlineFlagSources[0]
 	}
 
 	// Default to gRPC sync provider
@@ -743,7 +796,7 @@ func createSyncProvider(cfg Configuration, log *logger.Logger) (isync.ISync, str
 	log.Info("using gRPC sync provider with URI: " + uri)
 
 	return &Sync{
-		CredentialBuilder:       &credentials.CredentialBuilder{},
+		CredentialBuilder:       newCredentialBuilder(cfg),
 		GrpcDialOptionsOverride: cfg.GrpcDialOptionsOverride,
 		Logger:                  log,
 		Secure:                  cfg.TLSEnabled,
@@ -754,9 +807,19 @@ func createSyncProvider(cfg Configuration, log *logger.Logger) (isync.ISync, str
 		FatalStatusCodes:        cfg.FatalStatusCodes,
 		RetryBackOffMaxMs:       cfg.RetryBackOffMaxMs,
 		RetryBackOffMs:          cfg.RetryBackOffMs,
+		TokenSource:             cfg.TokenSource,
 	}, uri
 }
 
+// offlineFlagSources returns the paths of the offline flag sources, the single path first
+func (cfg Configuration) offlineFlagSources() []string {
+	var paths []string
+	if cfg.OfflineFlagSource != "" {
+		paths = append(paths, cfg.OfflineFlagSource)
+	}
+	return append(paths, cfg.OfflineFlagSources...)
+}
+
 // buildGrpcUri constructs the gRPC URI from configuration
 func buildGrpcUri(cfg Configuration) string {
 	if cfg.TargetUri != "" && isValidTargetScheme(cfg.TargetUri) {
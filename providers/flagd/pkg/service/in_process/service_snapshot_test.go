@@ -0,0 +1,220 @@
+package process
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/sync"
+	of "github.com/open-feature/go-sdk/openfeature"
+)
+
+var snapshotFlags = `{
+		"flags": {
+		  "myBoolFlag": {
+			"state": "ENABLED",
+			"variants": {
+			  "on": true,
+			  "off": false
+			},
+			"defaultVariant": "off"
+		  },
+		  "snapshotOnlyFlag": {
+			"state": "ENABLED",
+			"variants": {
+			  "on": true
+			},
+			"defaultVariant": "on"
+		  }
+		}
+	}`
+
+// channelSyncProvider sends the data pushed to its channel, simulating a flag source which is not yet available
+type channelSyncProvider struct {
+	data chan sync.DataSync
+}
+
+func (c *channelSyncProvider) Init(context.Context) error {
+	return nil
+}
+
+func (c *channelSyncProvider) IsReady() bool {
+	return true
+}
+
+func (c *channelSyncProvider) Sync(ctx context.Context, dataSync chan<- sync.DataSync) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case data := <-c.data:
+			dataSync <- data
+		}
+	}
+}
+
+func (c *channelSyncProvider) ReSync(context.Context, chan<- sync.DataSync) error {
+	return nil
+}
+
+func expectEvent(t *testing.T, events <-chan of.Event, eventType of.EventType) of.Event {
+	t.Helper()
+	select {
+	case event := <-events:
+		if event.EventType != eventType {
+			t.Fatalf("Expected event %s, but got %s with message %s", eventType, event.EventType, event.Message)
+		}
+		return event
+	case <-time.After(2 * time.Second):
+		t.Fatalf("Timeout waiting for event %s", eventType)
+		return of.Event{}
+	}
+}
+
+func TestInProcessSnapshot(t *testing.T) {
+	t.Run("persists each sync", func(t *testing.T) {
+		// given
+		offlinePath := filepath.Join(t.TempDir(), "config.json")
+		if err := os.WriteFile(offlinePath, []byte(flagRsp), 0644); err != nil {
+			t.Fatal(err)
+		}
+		snapshotPath := filepath.Join(t.TempDir(), "snapshot.json")
+
+		// when
+		service := NewInProcessService(Configuration{OfflineFlagSource: offlinePath, SnapshotPath: snapshotPath})
+		if err := service.Init(); err != nil {
+			t.Fatal(err)
+		}
+		defer service.Shutdown()
+		expectEvent(t, service.EventChannel(), of.ProviderReady)
+
+		// then
+		snapshot, err := os.ReadFile(snapshotPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(snapshot) != flagRsp {
+			t.Errorf("Expected the snapshot to contain the synced flags, but got %s", snapshot)
+		}
+		if service.LoadedSnapshot() {
+			t.Error("Expected no snapshot to be loaded")
+		}
+	})
+
+	t.Run("serves the snapshot until the first sync", func(t *testing.T) {
+		// given
+		snapshotPath := filepath.Join(t.TempDir(), "snapshot.json")
+		if err := os.WriteFile(snapshotPath, []byte(snapshotFlags), 0644); err != nil {
+			t.Fatal(err)
+		}
+		syncProvider := &channelSyncProvider{data: make(chan sync.DataSync)}
+
+		// when
+		service := NewInProcessService(Configuration{
+			CustomSyncProvider:    syncProvider,
+			CustomSyncProviderUri: "custom",
+			SnapshotPath:          snapshotPath,
+		})
+		if err := service.Init(); err != nil {
+			t.Fatal(err)
+		}
+		defer service.Shutdown()
+
+		// then
+		expectEvent(t, service.EventChannel(), of.ProviderStale)
+		if !service.LoadedSnapshot() {
+			t.Error("Expected the snapshot to be loaded")
+		}
+		if detail := service.ResolveBoolean(context.Background(), "myBoolFlag", true, nil); detail.Value {
+			t.Errorf("Expected the value of the snapshot, but got %v", detail)
+		}
+
+		// a live sync of another source replaces all flags of the snapshot
+		syncProvider.data <- sync.DataSync{FlagData: flagRsp, Source: "other"}
+		expectEvent(t, service.EventChannel(), of.ProviderReady)
+		changed := expectEvent(t, service.EventChannel(), of.ProviderConfigChange)
+		if len(changed.FlagChanges) != 2 {
+			t.Errorf("Expected both flags to change, but got %v", changed.FlagChanges)
+		}
+
+		if detail := service.ResolveBoolean(context.Background(), "myBoolFlag", false, nil); !detail.Value {
+			t.Errorf("Expected the synced value, but got %v", detail)
+		}
+		detail := service.ResolveBoolean(context.Background(), "snapshotOnlyFlag", false, nil)
+		if detail.ResolutionDetail().ErrorCode != of.FlagNotFoundCode {
+			t.Errorf("Expected the flag of the snapshot to be removed, but got %v", detail)
+		}
+
+		snapshot, err := os.ReadFile(snapshotPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(snapshot) != flagRsp {
+			t.Errorf("Expected the snapshot to be replaced, but got %s", snapshot)
+		}
+	})
+
+	t.Run("ready once a sync arrives right away", func(t *testing.T) {
+		// given
+		snapshotPath := filepath.Join(t.TempDir(), "snapshot.json")
+		if err := os.WriteFile(snapshotPath, []byte(snapshotFlags), 0644); err != nil {
+			t.Fatal(err)
+		}
+		syncProvider := &channelSyncProvider{data: make(chan sync.DataSync, 1)}
+		syncProvider.data <- sync.DataSync{FlagData: flagRsp, Source: "custom"}
+
+		// when
+		service := NewInProcessService(Configuration{
+			CustomSyncProvider:    syncProvider,
+			CustomSyncProviderUri: "custom",
+			SnapshotPath:          snapshotPath,
+		})
+		if err := service.Init(); err != nil {
+			t.Fatal(err)
+		}
+		defer service.Shutdown()
+
+		// then - the stale event of the snapshot is never sent after the ready event of the sync
+		expectEvent(t, service.EventChannel(), of.ProviderStale)
+		expectEvent(t, service.EventChannel(), of.ProviderReady)
+	})
+
+	t.Run("unchanged syncs are not written", func(t *testing.T) {
+		snapshotPath := filepath.Join(t.TempDir(), "snapshot.json")
+		service := NewInProcessService(Configuration{
+			CustomSyncProvider: NewDoNothingCustomSyncProvider(),
+			SnapshotPath:       snapshotPath,
+		})
+
+		service.persistSnapshot(sync.DataSync{FlagData: flagRsp})
+		if err := os.Remove(snapshotPath); err != nil {
+			t.Fatal(err)
+		}
+		service.persistSnapshot(sync.DataSync{FlagData: flagRsp})
+		if _, err := os.Stat(snapshotPath); !os.IsNotExist(err) {
+			t.Errorf("Expected the unchanged snapshot not to be written again, but got %v", err)
+		}
+
+		service.persistSnapshot(sync.DataSync{FlagData: snapshotFlags})
+		if snapshot, err := os.ReadFile(snapshotPath); err != nil || string(snapshot) != snapshotFlags {
+			t.Errorf("Expected the changed snapshot to be written, but got %s (%v)", snapshot, err)
+		}
+	})
+
+	t.Run("invalid snapshot", func(t *testing.T) {
+		snapshotPath := filepath.Join(t.TempDir(), "snapshot.json")
+		if err := os.WriteFile(snapshotPath, []byte("{"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		service := NewInProcessService(Configuration{
+			CustomSyncProvider: NewDoNothingCustomSyncProvider(),
+			SnapshotPath:       snapshotPath,
+		})
+
+		if service.loadSnapshot() || service.LoadedSnapshot() {
+			t.Error("Expected the invalid snapshot not to be loaded")
+		}
+	})
+}
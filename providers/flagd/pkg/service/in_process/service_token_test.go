@@ -0,0 +1,111 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"buf.build/gen/go/open-feature/flagd/grpc/go/flagd/sync/v1/syncv1grpc"
+	v1 "buf.build/gen/go/open-feature/flagd/protocolbuffers/go/flagd/sync/v1"
+	"github.com/open-feature/go-sdk-contrib/providers/flagd/internal/flagdmeta"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// TestTokenSource verifies that the token of the token source is sent as bearer token with the sync stream, also when
+// the dial options are overridden
+func TestTokenSource(t *testing.T) {
+	tests := []struct {
+		name        string
+		dialOptions []grpc.DialOption
+	}{
+		{name: "standard dial options"},
+		{name: "dial options override", dialOptions: []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			port := findFreePort(t)
+			listen, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", port))
+			if err != nil {
+				t.Fatalf("Failed to create listener: %v", err)
+			}
+			defer func() {
+				_ = listen.Close()
+			}()
+
+			headerReceived := make(chan string, 1)
+			grpcServer := grpc.NewServer()
+			syncv1grpc.RegisterFlagSyncServiceServer(grpcServer, &tokenCapturingServer{headerReceived: headerReceived})
+
+			serverDone := make(chan struct{})
+			go func() {
+				defer close(serverDone)
+				_ = grpcServer.Serve(listen)
+			}()
+			defer func() {
+				grpcServer.Stop()
+				<-serverDone
+			}()
+
+			var calls atomic.Int32
+			inProcessService := NewInProcessService(Configuration{
+				Host:                    "localhost",
+				Port:                    port,
+				GrpcDialOptionsOverride: tt.dialOptions,
+				RetryBackOffMaxMs:       2000,
+				RetryBackOffMs:          1000,
+				TokenSource: func(context.Context) (string, error) {
+					return fmt.Sprintf("token-%d", calls.Add(1)), nil
+				},
+			})
+
+			// when
+			if err := inProcessService.Init(); err != nil {
+				t.Fatalf("Failed to initialize service: %v", err)
+			}
+			defer inProcessService.Shutdown()
+
+			// then
+			select {
+			case header := <-headerReceived:
+				if header != "Bearer token-1" {
+					t.Errorf("Expected authorization header %q, but got %q", "Bearer token-1", header)
+				}
+			case <-time.After(3 * time.Second):
+				t.Fatal("Timeout waiting for authorization header")
+			}
+		})
+	}
+}
+
+// tokenCapturingServer captures the authorization header of the sync stream
+type tokenCapturingServer struct {
+	syncv1grpc.UnimplementedFlagSyncServiceServer
+	headerReceived chan string
+}
+
+func (s *tokenCapturingServer) SyncFlags(_ *v1.SyncFlagsRequest, stream syncv1grpc.FlagSyncService_SyncFlagsServer) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	header := ""
+	if values := md.Get(flagdmeta.Authorization); len(values) > 0 {
+		header = values[0]
+	}
+	select {
+	case s.headerReceived <- header:
+	default:
+	}
+
+	if err := stream.Send(&v1.SyncFlagsResponse{FlagConfiguration: flagRsp}); err != nil {
+		return err
+	}
+	<-stream.Context().Done()
+	return nil
+}
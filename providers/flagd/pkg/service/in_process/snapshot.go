@@ -0,0 +1,113 @@
+package process
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	isync "github.com/open-feature/flagd/core/pkg/sync"
+	of "github.com/open-feature/go-sdk/openfeature"
+	"go.uber.org/zap"
+)
+
+// emptyFlagConfiguration removes the flags of the snapshot once a live sync arrives for another source
+const emptyFlagConfiguration = `{"flags":{}}`
+
+// loadSnapshot loads the flags of the last-known-good snapshot into the store, if a snapshot path is configured and the
+// snapshot exists. The flags are stored for the source of the sync provider, so they are replaced by the first live
+// sync. Returns whether the snapshot was loaded.
+func (i *InProcess) loadSnapshot() bool {
+	path := i.configuration.SnapshotPath
+	if path == "" {
+		return false
+	}
+
+	flagData, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			i.logger.Warn("failed to read flag snapshot", zap.String("path", path), zap.Error(err))
+		}
+		return false
+	}
+
+	if err := i.evaluator.SetState(isync.DataSync{FlagData: string(flagData), Source: i.source}); err != nil {
+		i.logger.Warn("failed to load flag snapshot", zap.String("path", path), zap.Error(err))
+		return false
+	}
+
+	i.logger.Info("loaded flag snapshot", zap.String("path", path))
+	i.persistedSnapshot = string(flagData)
+	i.snapshotLoaded.Store(true)
+	i.servingSnapshot.Store(true)
+	return true
+}
+
+// LoadedSnapshot reports whether flags of the last-known-good snapshot were loaded on initialization
+func (i *InProcess) LoadedSnapshot() bool {
+	return i.snapshotLoaded.Load()
+}
+
+// sendSnapshotStale reports the provider as stale while it serves the flags of the snapshot
+func (i *InProcess) sendSnapshotStale() {
+	i.events <- of.Event{
+		ProviderName: providerName,
+		EventType:    of.ProviderStale,
+		ProviderEventDetails: of.ProviderEventDetails{
+			Message: "serving flags of snapshot " + i.configuration.SnapshotPath + " until the flag sync is available",
+		},
+	}
+}
+
+// replaceSnapshot removes the flags of the snapshot with the first live sync, if the sync provider reports another
+// source than the one the snapshot was loaded for. Flags of the same source are replaced by the sync itself.
+func (i *InProcess) replaceSnapshot(data isync.DataSync) {
+	if !i.servingSnapshot.Swap(false) || data.Source == i.source {
+		return
+	}
+	if err := i.evaluator.SetState(isync.DataSync{FlagData: emptyFlagConfiguration, Source: i.source}); err != nil {
+		i.logger.Error("failed to remove flags of the snapshot", zap.Error(err))
+	}
+}
+
+// persistSnapshot writes the flag configuration of a successful sync to the snapshot path, if configured. The snapshot
+// is written to a temporary file first and then renamed, so it is never read partially written. It holds the flag
+// configuration of the single sync source of the provider, as each sync replaces it as a whole, e.g. the merged files
+// of a multi-file source. Syncs carrying the configuration last written are not written again.
+func (i *InProcess) persistSnapshot(data isync.DataSync) {
+	path := i.configuration.SnapshotPath
+	if path == "" || data.FlagData == i.persistedSnapshot {
+		return
+	}
+	if err := writeFileAtomically(path, []byte(data.FlagData)); err != nil {
+		i.logger.Warn("failed to persist flag snapshot", zap.String("path", path), zap.Error(err))
+		return
+	}
+	i.persistedSnapshot = data.FlagData
+}
+
+func writeFileAtomically(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("create temporary file: %w", err)
+	}
+	defer func() {
+		// no-op once renamed
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write temporary file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("sync temporary file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close temporary file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("rename temporary file: %w", err)
+	}
+	return nil
+}
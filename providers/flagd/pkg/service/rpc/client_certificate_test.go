@@ -0,0 +1,188 @@
+package rpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	v2 "buf.build/gen/go/open-feature/flagd/protocolbuffers/go/flagd/evaluation/v2"
+	"connectrpc.com/connect"
+)
+
+// testCA issues the certificates of the mutual TLS tests
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: cert, key: key}
+}
+
+// issue writes a certificate for the common name and its key to PEM files in dir
+func (ca *testCA) issue(t *testing.T, dir string, commonName string, usage x509.ExtKeyUsage) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPath := filepath.Join(dir, "tls.crt")
+	keyPath := filepath.Join(dir, "tls.key")
+	writePEM(t, certPath, "CERTIFICATE", der)
+	writePEM(t, keyPath, "EC PRIVATE KEY", keyDer)
+	return certPath, keyPath
+}
+
+func writePEM(t *testing.T, path string, blockType string, der []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientCertificate(t *testing.T) {
+	ca := newTestCA(t)
+	dir := t.TempDir()
+	caPath := filepath.Join(dir, "ca.crt")
+	writePEM(t, caPath, "CERTIFICATE", ca.cert.Raw)
+
+	serverCert, serverKey := ca.issue(t, t.TempDir(), "flagd", x509.ExtKeyUsageServerAuth)
+	serverKeyPair, err := tls.LoadX509KeyPair(serverCert, serverKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientPool := x509.NewCertPool()
+	clientPool.AddCert(ca.cert)
+
+	var mu sync.Mutex
+	var clients []string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		clients = append(clients, r.TLS.PeerCertificates[0].Subject.CommonName)
+		w.WriteHeader(http.StatusNotImplemented)
+	}))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverKeyPair},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientPool,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	portNumber, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientDir := t.TempDir()
+	clientCert, clientKey := ca.issue(t, clientDir, "client", x509.ExtKeyUsageClientAuth)
+	client, _, err := newClient(Configuration{
+		Host:                  host,
+		Port:                  uint16(portNumber),
+		TLSEnabled:            true,
+		CertificatePath:       caPath,
+		ClientCertificatePath: clientCert,
+		ClientKeyPath:         clientKey,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// calls flagd until the server received the given number of requests, as a call may fail on a closed connection
+	callUntil := func(requests int) []string {
+		var received []string
+		for range 3 {
+			_, _ = client.ResolveBoolean(context.Background(), connect.NewRequest(&v2.ResolveBooleanRequest{FlagKey: "flag"}))
+			mu.Lock()
+			received = append([]string(nil), clients...)
+			mu.Unlock()
+			if len(received) >= requests {
+				break
+			}
+		}
+		return received
+	}
+
+	callUntil(1)
+
+	// rotate the client certificate, it is picked up by the next connection
+	ca.issue(t, clientDir, "rotated", x509.ExtKeyUsageClientAuth)
+	later := time.Now().Add(time.Minute)
+	for _, path := range []string{clientCert, clientKey} {
+		if err := os.Chtimes(path, later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+	server.CloseClientConnections()
+
+	if received := callUntil(2); len(received) != 2 || received[0] != "client" || received[1] != "rotated" {
+		t.Errorf("expected the client certificate before & after the rotation, got %v", received)
+	}
+
+	if _, _, err := newClient(Configuration{
+		TLSEnabled:            true,
+		ClientCertificatePath: clientCert,
+	}); err == nil {
+		t.Error("expected an error for a client certificate without key")
+	}
+}
@@ -0,0 +1,73 @@
+package rpc
+
+import (
+	"fmt"
+
+	"github.com/open-feature/go-sdk-contrib/providers/flagd/internal/cache"
+	"github.com/open-feature/go-sdk-contrib/providers/flagd/internal/logger"
+	of "github.com/open-feature/go-sdk/openfeature"
+)
+
+// newContextCache creates the context cache, if enabled by the configuration
+func newContextCache(cfg Configuration) *cache.ContextCache {
+	if cfg.ContextCacheSize <= 0 {
+		return nil
+	}
+	return cache.NewContextCache(cfg.ContextCacheSize, cfg.ContextCacheTTL)
+}
+
+// fromContextCache looks up the result of the flag cached for the evaluation context, if the context cache is enabled
+func (s *Service) fromContextCache(key string, evalCtx map[string]interface{}) (interface{}, bool) {
+	if s.contextCache == nil {
+		return nil, false
+	}
+	hash, err := contextHash(evalCtx)
+	if err != nil {
+		return nil, false
+	}
+	return s.contextCache.Get(key, hash)
+}
+
+// contextCacheGeneration returns the generation of the context cache to add the results of an evaluation starting now
+// with, see addToContextCache
+func (s *Service) contextCacheGeneration() uint64 {
+	if s.contextCache == nil {
+		return 0
+	}
+	return s.contextCache.Generation()
+}
+
+// addToContextCache caches the result of the flag for the evaluation context, if the context cache is enabled. The
+// result must be one of the typed resolution details. The generation must be taken before the evaluation started, so
+// the result is dropped if the flag changed meanwhile.
+func (s *Service) addToContextCache(key string, evalCtx map[string]interface{}, generation uint64, detail interface{}) {
+	if s.contextCache == nil {
+		return
+	}
+	hash, err := contextHash(evalCtx)
+	if err != nil {
+		s.logger.V(logger.Debug).Info(fmt.Sprintf("result of %s not cached: %v", key, err))
+		return
+	}
+	s.contextCache.Add(key, hash, generation, detail)
+}
+
+// typedDetail converts a result of a ResolveAll call to the resolution detail of its type. Numbers are not converted,
+// as float flags can not be told apart from integer flags, so they are left to the resolvers of their type.
+func typedDetail(flag prefetchedFlag) (interface{}, bool) {
+	detail := of.ProviderResolutionDetail{
+		Reason:       flag.reason,
+		Variant:      flag.variant,
+		FlagMetadata: flag.metadata,
+	}
+	switch value := flag.value.(type) {
+	case bool:
+		return of.BoolResolutionDetail{Value: value, ProviderResolutionDetail: detail}, true
+	case string:
+		return of.StringResolutionDetail{Value: value, ProviderResolutionDetail: detail}, true
+	case map[string]interface{}:
+		return of.InterfaceResolutionDetail{Value: value, ProviderResolutionDetail: detail}, true
+	default:
+		return nil, false
+	}
+}
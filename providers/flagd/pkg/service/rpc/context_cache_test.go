@@ -0,0 +1,181 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v2 "buf.build/gen/go/open-feature/flagd/protocolbuffers/go/flagd/evaluation/v2"
+	"github.com/open-feature/go-sdk-contrib/providers/flagd/internal/cache"
+	of "github.com/open-feature/go-sdk/openfeature"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func newContextCacheTestService(size int, ttl time.Duration) (*Service, *MockClient) {
+	client := &MockClient{
+		booleanResponse: v2.ResolveBooleanResponse{
+			Value:    ptrBool(true),
+			Reason:   string(of.TargetingMatchReason),
+			Variant:  ptrString("on"),
+			Metadata: metadataStruct,
+		},
+	}
+	return &Service{
+		cache:        cache.NewCacheService(cache.DisabledValue, 0, log),
+		contextCache: newContextCache(Configuration{ContextCacheSize: size, ContextCacheTTL: ttl}),
+		logger:       log,
+		client:       client,
+		events:       make(chan of.Event, 1),
+	}, client
+}
+
+func TestContextCache(t *testing.T) {
+	ctx := context.Background()
+	evalCtx := map[string]interface{}{of.TargetingKey: "user", "email": "user@example.com"}
+
+	t.Run("results are cached per evaluation context", func(t *testing.T) {
+		service, client := newContextCacheTestService(10, 0)
+		if detail := service.ResolveBoolean(ctx, flagKey, false, evalCtx); detail.Reason != of.TargetingMatchReason {
+			t.Fatalf("expected the result of flagd, got %v", detail)
+		}
+
+		// flagd would now return another result
+		client.booleanResponse.Value = ptrBool(false)
+		client.booleanResponse.Variant = ptrString("off")
+
+		sameCtx := map[string]interface{}{"email": "user@example.com", of.TargetingKey: "user"}
+		detail := service.ResolveBoolean(ctx, flagKey, false, sameCtx)
+		if !detail.Value || detail.Variant != "on" || detail.Reason != ReasonCached {
+			t.Errorf("expected the cached result, got %v", detail)
+		}
+
+		otherCtx := map[string]interface{}{of.TargetingKey: "other"}
+		if detail := service.ResolveBoolean(ctx, flagKey, false, otherCtx); detail.Value || detail.Reason == ReasonCached {
+			t.Errorf("expected the result of flagd for another context, got %v", detail)
+		}
+		if detail := service.ResolveString(ctx, flagKey, "", evalCtx); detail.Reason == ReasonCached {
+			t.Errorf("expected no cached result for another type, got %v", detail)
+		}
+	})
+
+	t.Run("default values of the caller are not cached", func(t *testing.T) {
+		service, client := newContextCacheTestService(10, 0)
+		client.stringResponse = v2.ResolveStringResponse{Reason: string(of.DefaultReason)}
+
+		if detail := service.ResolveString(ctx, flagKey, "first", evalCtx); detail.Value != "first" {
+			t.Fatalf("expected the default value, got %v", detail)
+		}
+		detail := service.ResolveString(ctx, flagKey, "second", evalCtx)
+		if detail.Value != "second" || detail.Reason != of.DefaultReason {
+			t.Errorf("expected the default value of the second caller, got %v", detail)
+		}
+		if service.contextCache.Len() != 0 {
+			t.Errorf("expected no cached results, got %d", service.contextCache.Len())
+		}
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		service, client := newContextCacheTestService(10, 0)
+		client.error = ErrClientNotReady
+		service.ResolveBoolean(ctx, flagKey, false, evalCtx)
+
+		if service.contextCache.Len() != 0 {
+			t.Errorf("expected no cached results, got %d", service.contextCache.Len())
+		}
+	})
+
+	t.Run("results are removed once the flag changed", func(t *testing.T) {
+		service, _ := newContextCacheTestService(10, 0)
+		service.ResolveBoolean(ctx, flagKey, false, evalCtx)
+		service.ResolveBoolean(ctx, "other", false, evalCtx)
+		service.ResolveBoolean(ctx, flagKey, false, map[string]interface{}{of.TargetingKey: "other"})
+
+		data, err := structpb.NewStruct(map[string]interface{}{
+			"flags": map[string]interface{}{flagKey: ""},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		service.handleConfigurationChangeEvent(ctx, &v2.EventStreamResponse{Data: data})
+
+		if _, ok := service.fromContextCache(flagKey, evalCtx); ok {
+			t.Errorf("expected the results of %s to be removed", flagKey)
+		}
+		if _, ok := service.fromContextCache("other", evalCtx); !ok {
+			t.Error("expected the results of other flags to be kept")
+		}
+		if event := <-service.EventChannel(); event.EventType != of.ProviderConfigChange {
+			t.Errorf("expected event %s, got %s", of.ProviderConfigChange, event.EventType)
+		}
+
+		service.handleConfigurationChangeEvent(ctx, &v2.EventStreamResponse{})
+		if service.contextCache.Len() != 0 {
+			t.Errorf("expected the cache to be purged, got %d results", service.contextCache.Len())
+		}
+	})
+
+	t.Run("results of evaluations started before a change are dropped", func(t *testing.T) {
+		service, _ := newContextCacheTestService(10, 0)
+		generation := service.contextCacheGeneration()
+		detail := of.BoolResolutionDetail{Value: true}
+
+		// the flag changes while it is evaluated
+		data, err := structpb.NewStruct(map[string]interface{}{
+			"flags": map[string]interface{}{flagKey: ""},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		service.handleConfigurationChangeEvent(ctx, &v2.EventStreamResponse{Data: data})
+		<-service.EventChannel()
+
+		service.addToContextCache(flagKey, evalCtx, generation, detail)
+		if _, ok := service.fromContextCache(flagKey, evalCtx); ok {
+			t.Errorf("expected the outdated result of %s to be dropped", flagKey)
+		}
+		service.addToContextCache("other", evalCtx, generation, detail)
+		if _, ok := service.fromContextCache("other", evalCtx); !ok {
+			t.Error("expected the result of an unchanged flag to be cached")
+		}
+		service.addToContextCache(flagKey, evalCtx, service.contextCacheGeneration(), detail)
+		if _, ok := service.fromContextCache(flagKey, evalCtx); !ok {
+			t.Errorf("expected the result of %s evaluated after the change to be cached", flagKey)
+		}
+
+		// all flags change while they are evaluated
+		generation = service.contextCacheGeneration()
+		service.purgeCaches()
+		service.addToContextCache("other", evalCtx, generation, detail)
+		if service.contextCache.Len() != 0 {
+			t.Errorf("expected the outdated results to be dropped, got %d results", service.contextCache.Len())
+		}
+	})
+
+	t.Run("size limit & ttl", func(t *testing.T) {
+		service, _ := newContextCacheTestService(2, 0)
+		for _, user := range []string{"a", "b", "c"} {
+			service.ResolveBoolean(ctx, flagKey, false, map[string]interface{}{of.TargetingKey: user})
+		}
+		if service.contextCache.Len() != 2 {
+			t.Errorf("expected 2 cached results, got %d", service.contextCache.Len())
+		}
+		if _, ok := service.fromContextCache(flagKey, map[string]interface{}{of.TargetingKey: "a"}); ok {
+			t.Error("expected the least recently used result to be evicted")
+		}
+
+		service, _ = newContextCacheTestService(10, 50*time.Millisecond)
+		service.ResolveBoolean(ctx, flagKey, false, evalCtx)
+		time.Sleep(100 * time.Millisecond)
+		if _, ok := service.fromContextCache(flagKey, evalCtx); ok {
+			t.Error("expected the result to expire")
+		}
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		service, _ := newContextCacheTestService(0, time.Minute)
+		service.ResolveBoolean(ctx, flagKey, false, evalCtx)
+		if detail := service.ResolveBoolean(ctx, flagKey, false, evalCtx); detail.Reason == ReasonCached {
+			t.Errorf("expected no cached result, got %v", detail)
+		}
+	})
+}
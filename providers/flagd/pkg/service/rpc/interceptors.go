@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"context"
+	"fmt"
 
 	"connectrpc.com/connect"
 	"github.com/open-feature/go-sdk-contrib/providers/flagd/internal/flagdmeta"
@@ -39,3 +40,58 @@ func (i *selectorInterceptor) WrapStreamingHandler(next connect.StreamingHandler
 	// server-side; not used by the client
 	return next
 }
+
+// tokenInterceptor is a connect.Interceptor that adds the Authorization header, calling the token source for each
+// request and stream.
+type tokenInterceptor struct {
+	tokenSource func(context.Context) (string, error)
+}
+
+func newTokenInterceptor(tokenSource func(context.Context) (string, error)) *tokenInterceptor {
+	return &tokenInterceptor{tokenSource: tokenSource}
+}
+
+func (i *tokenInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		token, err := i.tokenSource(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("token source: %w", err))
+		}
+		req.Header().Set(flagdmeta.Authorization, flagdmeta.BearerToken(token))
+		return next(ctx, req)
+	}
+}
+
+func (i *tokenInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		token, err := i.tokenSource(ctx)
+		if err != nil {
+			return &failedStreamingClientConn{
+				StreamingClientConn: conn,
+				err:                 connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("token source: %w", err)),
+			}
+		}
+		conn.RequestHeader().Set(flagdmeta.Authorization, flagdmeta.BearerToken(token))
+		return conn
+	}
+}
+
+func (i *tokenInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	// server-side; not used by the client
+	return next
+}
+
+// failedStreamingClientConn fails a stream before anything is sent, as interceptors can not fail opening a stream
+type failedStreamingClientConn struct {
+	connect.StreamingClientConn
+	err error
+}
+
+func (c *failedStreamingClientConn) Send(any) error {
+	return c.err
+}
+
+func (c *failedStreamingClientConn) Receive(any) error {
+	return c.err
+}
@@ -2,6 +2,8 @@ package rpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -101,3 +103,76 @@ func (h *headerCapturingHandler) ResolveBoolean(_ context.Context, req *connect.
 	h.received <- req.Header().Get(flagdmeta.Selector)
 	return connect.NewResponse(&schemaV2.ResolveBooleanResponse{}), nil
 }
+
+// TestTokenInterceptor_E2E verifies the token source is called for each request & stream, and its token arrives on the
+// server side as bearer token
+func TestTokenInterceptor_E2E(t *testing.T) {
+	received := make(chan string, 3)
+	mux := http.NewServeMux()
+	path, handler := schemaConnectV2.NewServiceHandler(&tokenCapturingHandler{received: received})
+	mux.Handle(path, handler)
+
+	srv := httptest.NewUnstartedServer(mux)
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	defer srv.Close()
+
+	calls := 0
+	tokenSource := func(context.Context) (string, error) {
+		calls++
+		if calls > 3 {
+			return "", errors.New("expired")
+		}
+		return fmt.Sprintf("token-%d", calls), nil
+	}
+	client := schemaConnectV2.NewServiceClient(srv.Client(), srv.URL,
+		connect.WithInterceptors(newTokenInterceptor(tokenSource)))
+
+	for range 2 {
+		_, err := client.ResolveBoolean(context.Background(), connect.NewRequest(&schemaV2.ResolveBooleanRequest{FlagKey: "k"}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	stream, err := client.EventStream(context.Background(), connect.NewRequest(&schemaV2.EventStreamRequest{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !stream.Receive() {
+		t.Fatalf("unexpected error: %v", stream.Err())
+	}
+	_ = stream.Close()
+
+	for _, want := range []string{"Bearer token-1", "Bearer token-2", "Bearer token-3"} {
+		if got := <-received; got != want {
+			t.Errorf("expected header %q, got %q", want, got)
+		}
+	}
+
+	_, err = client.ResolveBoolean(context.Background(), connect.NewRequest(&schemaV2.ResolveBooleanRequest{FlagKey: "k"}))
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("expected an unauthenticated error, got %v", err)
+	}
+	stream, err = client.EventStream(context.Background(), connect.NewRequest(&schemaV2.EventStreamRequest{}))
+	if err == nil && stream.Receive() {
+		t.Error("expected the stream to fail")
+	}
+	if err == nil && connect.CodeOf(stream.Err()) != connect.CodeUnauthenticated {
+		t.Errorf("expected an unauthenticated error, got %v", stream.Err())
+	}
+}
+
+type tokenCapturingHandler struct {
+	schemaConnectV2.UnimplementedServiceHandler
+	received chan string
+}
+
+func (h *tokenCapturingHandler) ResolveBoolean(_ context.Context, req *connect.Request[schemaV2.ResolveBooleanRequest]) (*connect.Response[schemaV2.ResolveBooleanResponse], error) {
+	h.received <- req.Header().Get(flagdmeta.Authorization)
+	return connect.NewResponse(&schemaV2.ResolveBooleanResponse{}), nil
+}
+
+func (h *tokenCapturingHandler) EventStream(_ context.Context, req *connect.Request[schemaV2.EventStreamRequest], stream *connect.ServerStream[schemaV2.EventStreamResponse]) error {
+	h.received <- req.Header().Get(flagdmeta.Authorization)
+	return stream.Send(&schemaV2.EventStreamResponse{Type: "provider_ready"})
+}
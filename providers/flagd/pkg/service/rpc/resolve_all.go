@@ -0,0 +1,179 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"time"
+
+	schemaV1 "buf.build/gen/go/open-feature/flagd/protocolbuffers/go/flagd/evaluation/v1"
+	"connectrpc.com/connect"
+	flagdModels "github.com/open-feature/flagd/core/pkg/model"
+	of "github.com/open-feature/go-sdk/openfeature"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// bulkClient is the part of the v1 evaluation client used for bulk evaluations
+type bulkClient interface {
+	ResolveAll(context.Context, *connect.Request[schemaV1.ResolveAllRequest]) (*connect.Response[schemaV1.ResolveAllResponse], error)
+}
+
+// prefetchKey is the context key of the results of a ResolveAll call
+type prefetchKey struct{}
+
+// prefetch holds the results of a ResolveAll call along with the hash of the evaluation context they were evaluated for
+type prefetch struct {
+	contextHash uint64
+	flags       map[string]prefetchedFlag
+}
+
+type prefetchedFlag struct {
+	value    interface{}
+	variant  string
+	reason   of.Reason
+	metadata map[string]interface{}
+}
+
+// ResolveAll evaluates all flags for the evaluation context with a single flagd ResolveAll rpc. If flag keys are given,
+// only the results of these flags are kept. The returned context carries the results, evaluations using it for the same
+// evaluation context are served from these results rather than calling flagd again. The results are also added to the
+// context cache and static results to the cache, if enabled, except numbers which may be integer or float flags. Flags
+// missing from the response, e.g. disabled ones, are still evaluated by flagd.
+func (s *Service) ResolveAll(ctx context.Context, flagKeys []string, evalCtx map[string]interface{}) (context.Context, error) {
+	if !s.isInitialised() {
+		return ctx, ErrClientNotReady
+	}
+
+	hash, err := contextHash(evalCtx)
+	if err != nil {
+		return ctx, of.NewParseErrorResolutionError(err.Error())
+	}
+
+	evalCtxF, err := structpb.NewStruct(evalCtx)
+	if err != nil {
+		s.logger.Error(err, "struct from evaluation context")
+		return ctx, of.NewParseErrorResolutionError(err.Error())
+	}
+
+	reqCtx := ctx
+	if s.deadlineMs > 0 {
+		var cancel context.CancelFunc
+		reqCtx, cancel = context.WithTimeout(ctx, time.Duration(s.deadlineMs)*time.Millisecond)
+		defer cancel()
+	}
+
+	generation := s.contextCacheGeneration()
+	res, err := s.bulkClient.ResolveAll(reqCtx, connect.NewRequest(&schemaV1.ResolveAllRequest{Context: evalCtxF}))
+	if err != nil {
+		return ctx, handleError(err)
+	}
+
+	wanted := make(map[string]struct{}, len(flagKeys))
+	for _, key := range flagKeys {
+		wanted[key] = struct{}{}
+	}
+
+	flags := make(map[string]prefetchedFlag, len(res.Msg.GetFlags()))
+	for key, flag := range res.Msg.GetFlags() {
+		if _, ok := wanted[key]; len(wanted) > 0 && !ok {
+			continue
+		}
+
+		prefetched := prefetchedFlag{
+			variant:  flag.GetVariant(),
+			reason:   of.Reason(flag.GetReason()),
+			metadata: flag.GetMetadata().AsMap(),
+		}
+		switch value := flag.GetValue().(type) {
+		case *schemaV1.AnyFlag_BoolValue:
+			prefetched.value = value.BoolValue
+		case *schemaV1.AnyFlag_StringValue:
+			prefetched.value = value.StringValue
+		case *schemaV1.AnyFlag_DoubleValue:
+			prefetched.value = value.DoubleValue
+		case *schemaV1.AnyFlag_ObjectValue:
+			prefetched.value = value.ObjectValue.AsMap()
+		}
+		flags[key] = prefetched
+		s.addToCache(key, evalCtx, generation, prefetched)
+	}
+
+	return context.WithValue(ctx, prefetchKey{}, &prefetch{contextHash: hash, flags: flags}), nil
+}
+
+// addToCache adds a result of a ResolveAll call to the context cache, and to the cache if it is static
+func (s *Service) addToCache(key string, evalCtx map[string]interface{}, generation uint64, flag prefetchedFlag) {
+	detail, ok := typedDetail(flag)
+	if !ok {
+		return
+	}
+	if s.cache.IsEnabled() && flag.reason == flagdModels.StaticReason {
+		s.cache.GetCache().Add(key, detail)
+	}
+	s.addToContextCache(key, evalCtx, generation, detail)
+}
+
+// prefetched looks up the result of the flag in the results of a ResolveAll call carried by the context. Results are
+// only used if they were evaluated for the same evaluation context and their value can be converted to the flag type.
+func prefetched[T any](ctx context.Context, key string, defaultValue T, evalCtx map[string]interface{},
+	convert func(interface{}) (T, bool),
+) (T, of.ProviderResolutionDetail, bool) {
+	p, ok := ctx.Value(prefetchKey{}).(*prefetch)
+	if !ok {
+		return defaultValue, of.ProviderResolutionDetail{}, false
+	}
+	flag, ok := p.flags[key]
+	if !ok {
+		return defaultValue, of.ProviderResolutionDetail{}, false
+	}
+	if hash, err := contextHash(evalCtx); err != nil || hash != p.contextHash {
+		return defaultValue, of.ProviderResolutionDetail{}, false
+	}
+
+	value := defaultValue
+	if !isDefaultOrDisabledFallback(flag.variant, flag.reason) && flag.value != nil {
+		if value, ok = convert(flag.value); !ok {
+			return defaultValue, of.ProviderResolutionDetail{}, false
+		}
+	}
+
+	return value, of.ProviderResolutionDetail{
+		Reason:       flag.reason,
+		Variant:      flag.variant,
+		FlagMetadata: flag.metadata,
+	}, true
+}
+
+// asType converts values decoded from a ResolveAll response to bool, string & float64 flags
+func asType[T bool | string | float64](value interface{}) (T, bool) {
+	v, ok := value.(T)
+	return v, ok
+}
+
+// asInt converts numbers decoded from a ResolveAll response to int flags, if they are whole numbers
+func asInt(value interface{}) (int64, bool) {
+	v, ok := value.(float64)
+	if !ok || v != math.Trunc(v) {
+		return 0, false
+	}
+	return int64(v), true
+}
+
+// asObject converts objects decoded from a ResolveAll response to object flags
+func asObject(value interface{}) (interface{}, bool) {
+	v, ok := value.(map[string]interface{})
+	return v, ok
+}
+
+// contextHash derives a stable hash of the evaluation context, as its JSON encoding orders the keys of maps
+func contextHash(evalCtx map[string]interface{}) (uint64, error) {
+	encoded, err := json.Marshal(evalCtx)
+	if err != nil {
+		return 0, fmt.Errorf("hash evaluation context: %w", err)
+	}
+	hash := fnv.New64a()
+	_, _ = hash.Write(encoded)
+	return hash.Sum64(), nil
+}
@@ -0,0 +1,199 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	schemaV1 "buf.build/gen/go/open-feature/flagd/protocolbuffers/go/flagd/evaluation/v1"
+	"connectrpc.com/connect"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/open-feature/go-sdk-contrib/providers/flagd/internal/cache"
+	of "github.com/open-feature/go-sdk/openfeature"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// MockBulkClient is a test mock for the bulk evaluation client
+type MockBulkClient struct {
+	response schemaV1.ResolveAllResponse
+	requests []*schemaV1.ResolveAllRequest
+
+	error error
+}
+
+func (m *MockBulkClient) ResolveAll(_ context.Context, req *connect.Request[schemaV1.ResolveAllRequest]) (*connect.Response[schemaV1.ResolveAllResponse], error) {
+	m.requests = append(m.requests, req.Msg)
+	return &connect.Response[schemaV1.ResolveAllResponse]{
+		Msg: &m.response,
+	}, m.error
+}
+
+func newBulkTestService(t *testing.T, cacheType cache.Type) (*Service, *MockBulkClient) {
+	t.Helper()
+	object, err := structpb.NewStruct(map[string]interface{}{"color": "red"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bulk := &MockBulkClient{
+		response: schemaV1.ResolveAllResponse{
+			Flags: map[string]*schemaV1.AnyFlag{
+				"bool": {
+					Reason:   string(of.TargetingMatchReason),
+					Variant:  "on",
+					Value:    &schemaV1.AnyFlag_BoolValue{BoolValue: true},
+					Metadata: metadataStruct,
+				},
+				"int": {
+					Reason:  string(of.StaticReason),
+					Variant: "ten",
+					Value:   &schemaV1.AnyFlag_DoubleValue{DoubleValue: 10},
+				},
+				"object": {
+					Reason:  string(of.TargetingMatchReason),
+					Variant: "red",
+					Value:   &schemaV1.AnyFlag_ObjectValue{ObjectValue: object},
+				},
+				"string": {
+					Reason:  string(of.DefaultReason),
+					Variant: "",
+				},
+				"static": {
+					Reason:  string(of.StaticReason),
+					Variant: "blue",
+					Value:   &schemaV1.AnyFlag_StringValue{StringValue: "blue"},
+				},
+			},
+		},
+	}
+
+	return &Service{
+		cache:  cache.NewCacheService(cacheType, 10, log),
+		logger: log,
+		// any call to flagd other than ResolveAll fails
+		client: &MockClient{
+			error: connect.NewError(connect.CodeUnavailable, errors.New("unavailable")),
+		},
+		bulkClient: bulk,
+	}, bulk
+}
+
+func TestResolveAll(t *testing.T) {
+	evalCtx := map[string]interface{}{of.TargetingKey: "user", "email": "user@example.com"}
+
+	t.Run("evaluations for the same context are served from the results", func(t *testing.T) {
+		service, bulk := newBulkTestService(t, cache.DisabledValue)
+		ctx, err := service.ResolveAll(context.Background(), nil, evalCtx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(bulk.requests) != 1 || bulk.requests[0].GetContext().AsMap()["email"] != "user@example.com" {
+			t.Fatalf("expected a single ResolveAll request for the evaluation context, got %v", bulk.requests)
+		}
+
+		sameCtx := map[string]interface{}{"email": "user@example.com", of.TargetingKey: "user"}
+		boolDetail := service.ResolveBoolean(ctx, "bool", false, sameCtx)
+		want := of.BoolResolutionDetail{
+			Value: true,
+			ProviderResolutionDetail: of.ProviderResolutionDetail{
+				Reason:       of.TargetingMatchReason,
+				Variant:      "on",
+				FlagMetadata: metadata,
+			},
+		}
+		if diff := cmp.Diff(want, boolDetail, cmpopts.EquateComparable(of.ResolutionError{})); diff != "" {
+			t.Errorf("unexpected bool result (-want +got):\n%s", diff)
+		}
+
+		if detail := service.ResolveInt(ctx, "int", 1, evalCtx); detail.Value != 10 || detail.Variant != "ten" {
+			t.Errorf("expected int value 10 of variant ten, got %v", detail)
+		}
+		if detail := service.ResolveFloat(ctx, "int", 1, evalCtx); detail.Value != 10 {
+			t.Errorf("expected float value 10, got %v", detail)
+		}
+		objectDetail := service.ResolveObject(ctx, "object", nil, evalCtx)
+		if diff := cmp.Diff(map[string]interface{}{"color": "red"}, objectDetail.Value); diff != "" {
+			t.Errorf("unexpected object value (-want +got):\n%s", diff)
+		}
+		if detail := service.ResolveString(ctx, "string", "fallback", evalCtx); detail.Value != "fallback" || detail.Reason != of.DefaultReason {
+			t.Errorf("expected the default value for a default result, got %v", detail)
+		}
+	})
+
+	t.Run("other contexts, types & flags are evaluated by flagd", func(t *testing.T) {
+		service, _ := newBulkTestService(t, cache.DisabledValue)
+		ctx, err := service.ResolveAll(context.Background(), nil, evalCtx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		otherCtx := map[string]interface{}{of.TargetingKey: "other"}
+		if detail := service.ResolveBoolean(ctx, "bool", false, otherCtx); detail.ResolutionDetail().ErrorCode != of.ProviderNotReadyCode {
+			t.Errorf("expected a call to flagd for another context, got %v", detail)
+		}
+		if detail := service.ResolveString(ctx, "bool", "", evalCtx); detail.ResolutionDetail().ErrorCode != of.ProviderNotReadyCode {
+			t.Errorf("expected a call to flagd for a type mismatch, got %v", detail)
+		}
+		if detail := service.ResolveBoolean(ctx, "missing", false, evalCtx); detail.ResolutionDetail().ErrorCode != of.ProviderNotReadyCode {
+			t.Errorf("expected a call to flagd for a missing flag, got %v", detail)
+		}
+		if detail := service.ResolveBoolean(context.Background(), "bool", false, evalCtx); detail.ResolutionDetail().ErrorCode != of.ProviderNotReadyCode {
+			t.Errorf("expected a call to flagd without the results in the context, got %v", detail)
+		}
+	})
+
+	t.Run("only the requested flags are kept", func(t *testing.T) {
+		service, _ := newBulkTestService(t, cache.DisabledValue)
+		ctx, err := service.ResolveAll(context.Background(), []string{"int"}, evalCtx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if detail := service.ResolveInt(ctx, "int", 1, evalCtx); detail.Value != 10 {
+			t.Errorf("expected int value 10, got %v", detail)
+		}
+		if detail := service.ResolveBoolean(ctx, "bool", false, evalCtx); detail.ResolutionDetail().ErrorCode != of.ProviderNotReadyCode {
+			t.Errorf("expected a call to flagd for a flag not requested, got %v", detail)
+		}
+	})
+
+	t.Run("static results are cached", func(t *testing.T) {
+		service, _ := newBulkTestService(t, cache.InMemValue)
+		if _, err := service.ResolveAll(context.Background(), nil, evalCtx); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, ok := service.cache.GetCache().Get("bool"); ok {
+			t.Error("expected targeting match results not to be cached")
+		}
+		detail := service.ResolveString(context.Background(), "static", "", map[string]interface{}{})
+		if detail.Value != "blue" || detail.Reason != ReasonCached {
+			t.Errorf("expected the cached value, got %v", detail)
+		}
+
+		// numbers can be int or float flags, so they are left to be cached by the resolver of their type
+		if _, ok := service.cache.GetCache().Get("int"); ok {
+			t.Error("expected numbers not to be cached")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		service, bulk := newBulkTestService(t, cache.DisabledValue)
+		bulk.error = connect.NewError(connect.CodeUnavailable, errors.New("unavailable"))
+		ctx := context.Background()
+		resultCtx, err := service.ResolveAll(ctx, nil, evalCtx)
+		var rErr of.ResolutionError
+		if !errors.As(err, &rErr) || rErr.Error() != of.NewProviderNotReadyResolutionError(ConnectionError).Error() {
+			t.Errorf("expected a provider not ready error, got %v", err)
+		}
+		if resultCtx != ctx {
+			t.Error("expected the context to be returned as is")
+		}
+
+		service.client = nil
+		if _, err := service.ResolveAll(ctx, nil, evalCtx); !errors.Is(err, ErrClientNotReady) {
+			t.Errorf("expected %v, got %v", ErrClientNotReady, err)
+		}
+	})
+}
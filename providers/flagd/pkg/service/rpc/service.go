@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	schemaConnectV1 "buf.build/gen/go/open-feature/flagd/connectrpc/go/flagd/evaluation/v1/evaluationv1connect"
 	schemaConnectV2 "buf.build/gen/go/open-feature/flagd/connectrpc/go/flagd/evaluation/v2/evaluationv2connect"
 	schemaV2 "buf.build/gen/go/open-feature/flagd/protocolbuffers/go/flagd/evaluation/v2"
 	"connectrpc.com/connect"
@@ -19,6 +20,7 @@ import (
 	flagdModels "github.com/open-feature/flagd/core/pkg/model"
 	flagdService "github.com/open-feature/flagd/core/pkg/service"
 	"github.com/open-feature/go-sdk-contrib/providers/flagd/internal/cache"
+	"github.com/open-feature/go-sdk-contrib/providers/flagd/internal/clientcert"
 	"github.com/open-feature/go-sdk-contrib/providers/flagd/internal/logger"
 	of "github.com/open-feature/go-sdk/openfeature"
 	"golang.org/x/net/context"
@@ -41,11 +43,21 @@ type Configuration struct {
 	OtelInterceptor bool
 	DeadlineMs      int
 	Selector        string
+	// ClientCertificatePath & ClientKeyPath configure the client certificate presented to flagd, reloaded once rotated
+	ClientCertificatePath string
+	ClientKeyPath         string
+	// TokenSource provides the bearer token sent with each request & stream, if set
+	TokenSource func(context.Context) (string, error)
+	// ContextCacheSize enables the context cache holding up to this number of results, if greater than zero
+	ContextCacheSize int
+	// ContextCacheTTL is the time results are kept in the context cache, results do not expire if zero
+	ContextCacheTTL time.Duration
 }
 
 // Service handles the client side  interface for the flagd server
 type Service struct {
 	cache        *cache.Service
+	contextCache *cache.ContextCache
 	cfg          Configuration
 	events       chan of.Event
 	logger       logr.Logger
@@ -53,6 +65,7 @@ type Service struct {
 	deadlineMs   int
 
 	client      schemaConnectV2.ServiceClient
+	bulkClient  bulkClient
 	cancelHook  context.CancelFunc
 	wg          sync.WaitGroup
 	streamReady chan error // Channel to signal when event stream is connected
@@ -61,6 +74,7 @@ type Service struct {
 func NewService(cfg Configuration, cache *cache.Service, logger logr.Logger, retries int) *Service {
 	logger.Info("operating in rpc mode with flags sourced from " + fmt.Sprintf("%s:%d", cfg.Host, cfg.Port))
 	return &Service{
+		contextCache: newContextCache(cfg),
 		cache:        cache,
 		cfg:          cfg,
 		events:       make(chan of.Event, 1),
@@ -85,7 +99,7 @@ type resolutionResponseConstraints interface {
 
 func (s *Service) Init() error {
 	var err error
-	s.client, err = newClient(s.cfg)
+	s.client, s.bulkClient, err = newClient(s.cfg)
 	if err != nil {
 		return err
 	}
@@ -126,6 +140,17 @@ func (s *Service) ResolveBoolean(ctx context.Context, key string, defaultValue b
 		}
 	}
 
+	if fromCache, ok := s.fromContextCache(key, evalCtx); ok {
+		if fromCacheResDetail, ok := fromCache.(of.BoolResolutionDetail); ok {
+			fromCacheResDetail.Reason = ReasonCached
+			return fromCacheResDetail
+		}
+	}
+
+	if value, detail, ok := prefetched(ctx, key, defaultValue, evalCtx, asType[bool]); ok {
+		return of.BoolResolutionDetail{Value: value, ProviderResolutionDetail: detail}
+	}
+
 	if !s.isInitialised() {
 		return of.BoolResolutionDetail{
 			Value: defaultValue,
@@ -136,6 +161,7 @@ func (s *Service) ResolveBoolean(ctx context.Context, key string, defaultValue b
 	}
 
 	var e of.ResolutionError
+	generation := s.contextCacheGeneration()
 	resp, err := resolve[schemaV2.ResolveBooleanRequest, schemaV2.ResolveBooleanResponse](
 		ctx, s.logger, s.deadlineMs, s.client.ResolveBoolean, key, evalCtx,
 	)
@@ -173,6 +199,10 @@ func (s *Service) ResolveBoolean(ctx context.Context, key string, defaultValue b
 	if s.cache.IsEnabled() && detail.Reason == flagdModels.StaticReason {
 		s.cache.GetCache().Add(key, detail)
 	}
+	// the value of a default or disabled result without variant is the default value of the caller
+	if !isDefaultOrDisabledFallback(variant, reason) {
+		s.addToContextCache(key, evalCtx, generation, detail)
+	}
 
 	return detail
 }
@@ -192,6 +222,17 @@ func (s *Service) ResolveString(ctx context.Context, key string, defaultValue st
 		}
 	}
 
+	if fromCache, ok := s.fromContextCache(key, evalCtx); ok {
+		if fromCacheResDetail, ok := fromCache.(of.StringResolutionDetail); ok {
+			fromCacheResDetail.Reason = ReasonCached
+			return fromCacheResDetail
+		}
+	}
+
+	if value, detail, ok := prefetched(ctx, key, defaultValue, evalCtx, asType[string]); ok {
+		return of.StringResolutionDetail{Value: value, ProviderResolutionDetail: detail}
+	}
+
 	if !s.isInitialised() {
 		return of.StringResolutionDetail{
 			Value: defaultValue,
@@ -202,6 +243,7 @@ func (s *Service) ResolveString(ctx context.Context, key string, defaultValue st
 	}
 
 	var e of.ResolutionError
+	generation := s.contextCacheGeneration()
 	resp, err := resolve[schemaV2.ResolveStringRequest, schemaV2.ResolveStringResponse](
 		ctx, s.logger, s.deadlineMs, s.client.ResolveString, key, evalCtx,
 	)
@@ -239,6 +281,10 @@ func (s *Service) ResolveString(ctx context.Context, key string, defaultValue st
 	if s.cache.IsEnabled() && detail.Reason == flagdModels.StaticReason {
 		s.cache.GetCache().Add(key, detail)
 	}
+	// the value of a default or disabled result without variant is the default value of the caller
+	if !isDefaultOrDisabledFallback(variant, reason) {
+		s.addToContextCache(key, evalCtx, generation, detail)
+	}
 
 	return detail
 }
@@ -258,6 +304,17 @@ func (s *Service) ResolveFloat(ctx context.Context, key string, defaultValue flo
 		}
 	}
 
+	if fromCache, ok := s.fromContextCache(key, evalCtx); ok {
+		if fromCacheResDetail, ok := fromCache.(of.FloatResolutionDetail); ok {
+			fromCacheResDetail.Reason = ReasonCached
+			return fromCacheResDetail
+		}
+	}
+
+	if value, detail, ok := prefetched(ctx, key, defaultValue, evalCtx, asType[float64]); ok {
+		return of.FloatResolutionDetail{Value: value, ProviderResolutionDetail: detail}
+	}
+
 	if !s.isInitialised() {
 		return of.FloatResolutionDetail{
 			Value: defaultValue,
@@ -268,6 +325,7 @@ func (s *Service) ResolveFloat(ctx context.Context, key string, defaultValue flo
 	}
 
 	var e of.ResolutionError
+	generation := s.contextCacheGeneration()
 	resp, err := resolve[schemaV2.ResolveFloatRequest, schemaV2.ResolveFloatResponse](
 		ctx, s.logger, s.deadlineMs, s.client.ResolveFloat, key, evalCtx,
 	)
@@ -305,6 +363,10 @@ func (s *Service) ResolveFloat(ctx context.Context, key string, defaultValue flo
 	if s.cache.IsEnabled() && detail.Reason == flagdModels.StaticReason {
 		s.cache.GetCache().Add(key, detail)
 	}
+	// the value of a default or disabled result without variant is the default value of the caller
+	if !isDefaultOrDisabledFallback(variant, reason) {
+		s.addToContextCache(key, evalCtx, generation, detail)
+	}
 
 	return detail
 }
@@ -324,6 +386,17 @@ func (s *Service) ResolveInt(ctx context.Context, key string, defaultValue int64
 		}
 	}
 
+	if fromCache, ok := s.fromContextCache(key, evalCtx); ok {
+		if fromCacheResDetail, ok := fromCache.(of.IntResolutionDetail); ok {
+			fromCacheResDetail.Reason = ReasonCached
+			return fromCacheResDetail
+		}
+	}
+
+	if value, detail, ok := prefetched(ctx, key, defaultValue, evalCtx, asInt); ok {
+		return of.IntResolutionDetail{Value: value, ProviderResolutionDetail: detail}
+	}
+
 	if !s.isInitialised() {
 		return of.IntResolutionDetail{
 			Value: defaultValue,
@@ -334,6 +407,7 @@ func (s *Service) ResolveInt(ctx context.Context, key string, defaultValue int64
 	}
 
 	var e of.ResolutionError
+	generation := s.contextCacheGeneration()
 	resp, err := resolve[schemaV2.ResolveIntRequest, schemaV2.ResolveIntResponse](
 		ctx, s.logger, s.deadlineMs, s.client.ResolveInt, key, evalCtx,
 	)
@@ -371,6 +445,10 @@ func (s *Service) ResolveInt(ctx context.Context, key string, defaultValue int64
 	if s.cache.IsEnabled() && detail.Reason == flagdModels.StaticReason {
 		s.cache.GetCache().Add(key, detail)
 	}
+	// the value of a default or disabled result without variant is the default value of the caller
+	if !isDefaultOrDisabledFallback(variant, reason) {
+		s.addToContextCache(key, evalCtx, generation, detail)
+	}
 
 	return detail
 }
@@ -389,6 +467,17 @@ func (s *Service) ResolveObject(ctx context.Context, key string, defaultValue in
 		}
 	}
 
+	if fromCache, ok := s.fromContextCache(key, evalCtx); ok {
+		if fromCacheResDetail, ok := fromCache.(of.InterfaceResolutionDetail); ok {
+			fromCacheResDetail.Reason = ReasonCached
+			return fromCacheResDetail
+		}
+	}
+
+	if value, detail, ok := prefetched(ctx, key, defaultValue, evalCtx, asObject); ok {
+		return of.InterfaceResolutionDetail{Value: value, ProviderResolutionDetail: detail}
+	}
+
 	if !s.isInitialised() {
 		return of.InterfaceResolutionDetail{
 			Value: defaultValue,
@@ -399,6 +488,7 @@ func (s *Service) ResolveObject(ctx context.Context, key string, defaultValue in
 	}
 
 	var e of.ResolutionError
+	generation := s.contextCacheGeneration()
 	resp, err := resolve[schemaV2.ResolveObjectRequest, schemaV2.ResolveObjectResponse](
 		ctx, s.logger, s.deadlineMs, s.client.ResolveObject, key, evalCtx,
 	)
@@ -436,6 +526,10 @@ func (s *Service) ResolveObject(ctx context.Context, key string, defaultValue in
 	if s.cache.IsEnabled() && detail.Reason == flagdModels.StaticReason {
 		s.cache.GetCache().Add(key, detail)
 	}
+	// the value of a default or disabled result without variant is the default value of the caller
+	if !isDefaultOrDisabledFallback(variant, reason) {
+		s.addToContextCache(key, evalCtx, generation, detail)
+	}
 
 	return detail
 }
@@ -528,9 +622,7 @@ func (s *Service) startEventStream(ctx context.Context) {
 
 			// error in stream handler, purge cache if available and retry
 			s.logger.V(logger.Warn).Info(fmt.Sprintf("connection to event stream failed (%q), attempting again", err))
-			if s.cache.IsEnabled() {
-				s.cache.GetCache().Purge()
-			}
+			s.purgeCaches()
 		}
 
 		select {
@@ -545,6 +637,9 @@ func (s *Service) startEventStream(ctx context.Context) {
 
 	// retry attempts exhausted. Disable cache and emit error event
 	s.cache.Disable()
+	if s.contextCache != nil {
+		s.contextCache.Disable()
+	}
 	connErr := fmt.Errorf("grpc connection establishment failed")
 
 	// Signal error if we haven't signaled success yet
@@ -619,34 +714,39 @@ func (s *Service) streamClient(ctx context.Context, streamReadySignaled *bool) e
 }
 
 func (s *Service) handleConfigurationChangeEvent(ctx context.Context, event *schemaV2.EventStreamResponse) {
-	if !s.cache.IsEnabled() {
+	if !s.cache.IsEnabled() && s.contextCache == nil {
 		return
 	}
 
 	if event.Data == nil {
 		// purge cache and return
-		s.cache.GetCache().Purge()
+		s.purgeCaches()
 		return
 	}
 
 	flagsVal, ok := event.Data.AsMap()["flags"]
 	if !ok {
 		// purge cache and return
-		s.cache.GetCache().Purge()
+		s.purgeCaches()
 		return
 	}
 
 	flags, ok := flagsVal.(map[string]interface{})
 	if !ok {
 		// purge cache and return
-		s.cache.GetCache().Purge()
+		s.purgeCaches()
 		return
 	}
 
 	keys := make([]string, len(flags))
 
 	for flagKey := range flags {
-		s.cache.GetCache().Remove(flagKey)
+		if s.cache.IsEnabled() {
+			s.cache.GetCache().Remove(flagKey)
+		}
+		if s.contextCache != nil {
+			s.contextCache.RemoveFlag(flagKey)
+		}
 		keys = append(keys, flagKey)
 	}
 
@@ -660,6 +760,16 @@ func (s *Service) handleConfigurationChangeEvent(ctx context.Context, event *sch
 	})
 }
 
+// purgeCaches purges the cache and the context cache, if enabled
+func (s *Service) purgeCaches() {
+	if s.cache.IsEnabled() {
+		s.cache.GetCache().Purge()
+	}
+	if s.contextCache != nil {
+		s.contextCache.Purge()
+	}
+}
+
 func (s *Service) handleReadyEvent(ctx context.Context) {
 	s.sendEvent(ctx, of.Event{
 		ProviderName: "flagd",
@@ -683,8 +793,9 @@ func derefString(s *string) string {
 	return ""
 }
 
-// newClient is a helper to derive schemaConnectV2.ServiceClient
-func newClient(cfg Configuration) (schemaConnectV2.ServiceClient, error) {
+// newClient is a helper to derive schemaConnectV2.ServiceClient, along with the schemaConnectV1.ServiceClient used for
+// the ResolveAll rpc not available in v2
+func newClient(cfg Configuration) (schemaConnectV2.ServiceClient, schemaConnectV1.ServiceClient, error) {
 	var dialContext func(ctx context.Context, network string, addr string) (net.Conn, error)
 	var tlsConfig *tls.Config
 	url := fmt.Sprintf("http://%s:%d", cfg.Host, cfg.Port)
@@ -701,14 +812,21 @@ func newClient(cfg Configuration) (schemaConnectV2.ServiceClient, error) {
 		if cfg.CertificatePath != "" {
 			caCert, err := os.ReadFile(cfg.CertificatePath)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			caCertPool := x509.NewCertPool()
 			if !caCertPool.AppendCertsFromPEM(caCert) {
-				return nil, errors.New("error appending provider certificate file. please check and try again")
+				return nil, nil, errors.New("error appending provider certificate file. please check and try again")
 			}
 			tlsConfig.RootCAs = caCertPool
 		}
+		if cfg.ClientCertificatePath != "" || cfg.ClientKeyPath != "" {
+			keyPair, err := clientcert.NewKeyPair(cfg.ClientCertificatePath, cfg.ClientKeyPath)
+			if err != nil {
+				return nil, nil, err
+			}
+			tlsConfig.GetClientCertificate = keyPair.GetClientCertificate
+		}
 	}
 
 	// build options
@@ -717,7 +835,7 @@ func newClient(cfg Configuration) (schemaConnectV2.ServiceClient, error) {
 	if cfg.OtelInterceptor {
 		interceptor, err := otelconnect.NewInterceptor()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		options = append(options, connect.WithInterceptors(interceptor))
@@ -727,14 +845,17 @@ func newClient(cfg Configuration) (schemaConnectV2.ServiceClient, error) {
 		options = append(options, connect.WithInterceptors(newSelectorInterceptor(cfg.Selector)))
 	}
 
-	return schemaConnectV2.NewServiceClient(
-		&http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: tlsConfig,
-				DialContext:     dialContext,
-			},
+	if cfg.TokenSource != nil {
+		options = append(options, connect.WithInterceptors(newTokenInterceptor(cfg.TokenSource)))
+	}
+
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: tlsConfig,
+			DialContext:     dialContext,
 		},
-		url,
-		options...,
-	), nil
+	}
+	return schemaConnectV2.NewServiceClient(httpClient, url, options...),
+		schemaConnectV1.NewServiceClient(httpClient, url, options...),
+		nil
 }
@@ -0,0 +1,309 @@
+// Package factories provides the multiprovider.ProviderFactory of the flagd, ofrep, from-env and go-feature-flag
+// providers, so configuration files can use these provider types. It is a module of its own, keeping the dependencies of
+// these providers out of the multi-provider module.
+package factories
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	flagd "github.com/open-feature/go-sdk-contrib/providers/flagd/pkg"
+	fromEnv "github.com/open-feature/go-sdk-contrib/providers/from-env/pkg"
+	gofeatureflag "github.com/open-feature/go-sdk-contrib/providers/go-feature-flag/pkg"
+	multiprovider "github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg"
+	"github.com/open-feature/go-sdk-contrib/providers/ofrep"
+	of "github.com/open-feature/go-sdk/openfeature"
+)
+
+const (
+	// TypeFlagd Provider type of the flagd provider, see Flagd
+	TypeFlagd = "flagd"
+	// TypeOFREP Provider type of the OFREP provider, see OFREP
+	TypeOFREP = "ofrep"
+	// TypeFromEnv Provider type of the from-env provider, see FromEnv
+	TypeFromEnv = "from-env"
+	// TypeGoFeatureFlag Provider type of the GO Feature Flag provider, see GoFeatureFlag
+	TypeGoFeatureFlag = "go-feature-flag"
+)
+
+// Register Registers the factories of all provider types of this package with the registry
+func Register(registry *multiprovider.ProviderRegistry) error {
+	for _, entry := range []struct {
+		providerType string
+		factory      multiprovider.ProviderFactory
+	}{
+		{TypeFlagd, Flagd},
+		{TypeOFREP, OFREP},
+		{TypeFromEnv, FromEnv},
+		{TypeGoFeatureFlag, GoFeatureFlag},
+	} {
+		if err := registry.Register(entry.providerType, entry.factory); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flagd Creates a flagd provider. The options are `resolver` (`rpc`, `in-process` or `file`), `host`, `port`,
+// `targetUri`, `tls`, `certPath`, `selector`, `providerId`, `offlineFlagSourcePath` and `deadlineMs`. Options that are
+// not set fall back to the environment variables of the flagd provider.
+func Flagd(options map[string]any) (of.FeatureProvider, error) {
+	o := factoryOptions(options)
+	if err := o.check("resolver", "host", "port", "targetUri", "tls", "certPath", "selector", "providerId",
+		"offlineFlagSourcePath", "deadlineMs"); err != nil {
+		return nil, err
+	}
+
+	var opts []flagd.ProviderOption
+	resolver, err := o.string("resolver")
+	if err != nil {
+		return nil, err
+	}
+	switch resolver {
+	case "":
+	case "rpc":
+		opts = append(opts, flagd.WithRPCResolver())
+	case "in-process":
+		opts = append(opts, flagd.WithInProcessResolver())
+	case "file":
+		opts = append(opts, flagd.WithFileResolver())
+	default:
+		return nil, fmt.Errorf("resolver: unknown resolver %q, expected one of [file in-process rpc]", resolver)
+	}
+
+	// enabling TLS resets the certificate path, so it goes first
+	tls, err := o.bool("tls")
+	if err != nil {
+		return nil, err
+	}
+	if tls {
+		opts = append(opts, flagd.WithTLS(""))
+	}
+	for _, field := range []struct {
+		name  string
+		apply func(string) flagd.ProviderOption
+	}{
+		{"host", flagd.WithHost},
+		{"targetUri", flagd.WithTargetUri},
+		{"certPath", flagd.WithCertificatePath},
+		{"selector", flagd.WithSelector},
+		{"providerId", flagd.WithProviderID},
+		{"offlineFlagSourcePath", flagd.WithOfflineFilePath},
+	} {
+		value, err := o.string(field.name)
+		if err != nil {
+			return nil, err
+		}
+		if value != "" {
+			opts = append(opts, field.apply(value))
+		}
+	}
+
+	port, set, err := o.int("port")
+	if err != nil {
+		return nil, err
+	}
+	if set {
+		if port <= 0 || port > 65535 {
+			return nil, errors.New("port: must be between 1 and 65535")
+		}
+		opts = append(opts, flagd.WithPort(uint16(port)))
+	}
+	deadlineMs, set, err := o.int("deadlineMs")
+	if err != nil {
+		return nil, err
+	}
+	if set {
+		opts = append(opts, flagd.WithDeadline(deadlineMs))
+	}
+
+	return flagd.NewProvider(opts...)
+}
+
+// OFREP Creates an OFREP provider. The options are `baseUri`, which is required, `bearerToken`, `apiKey`, `headers` and
+// `timeout`, e.g. `2s`.
+func OFREP(options map[string]any) (of.FeatureProvider, error) {
+	o := factoryOptions(options)
+	if err := o.check("baseUri", "bearerToken", "apiKey", "headers", "timeout"); err != nil {
+		return nil, err
+	}
+
+	baseURI, err := o.string("baseUri")
+	if err != nil {
+		return nil, err
+	}
+	if baseURI == "" {
+		return nil, errors.New("baseUri: is required")
+	}
+
+	var opts []ofrep.Option
+	token, err := o.string("bearerToken")
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		opts = append(opts, ofrep.WithBearerToken(token))
+	}
+	key, err := o.string("apiKey")
+	if err != nil {
+		return nil, err
+	}
+	if key != "" {
+		opts = append(opts, ofrep.WithApiKeyAuth(key))
+	}
+	headers, err := o.stringMap("headers")
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range slices.Sorted(maps.Keys(headers)) {
+		opts = append(opts, ofrep.WithHeader(name, headers[name]))
+	}
+	timeout, set, err := o.duration("timeout")
+	if err != nil {
+		return nil, err
+	}
+	if set {
+		opts = append(opts, ofrep.WithTimeout(timeout))
+	}
+
+	return ofrep.NewProvider(baseURI, opts...), nil
+}
+
+// FromEnv Creates a from-env provider, which takes no options
+func FromEnv(options map[string]any) (of.FeatureProvider, error) {
+	if err := factoryOptions(options).check(); err != nil {
+		return nil, err
+	}
+	return fromEnv.NewProvider(), nil
+}
+
+// GoFeatureFlag Creates a GO Feature Flag provider. The options are `endpoint`, which is required, `apiKey`, `headers`
+// and `evaluationType` (`INPROCESS` or `REMOTE`).
+func GoFeatureFlag(options map[string]any) (of.FeatureProvider, error) {
+	o := factoryOptions(options)
+	if err := o.check("endpoint", "apiKey", "headers", "evaluationType"); err != nil {
+		return nil, err
+	}
+
+	var providerOptions gofeatureflag.ProviderOptions
+	var err error
+	if providerOptions.Endpoint, err = o.string("endpoint"); err != nil {
+		return nil, err
+	}
+	if providerOptions.Endpoint == "" {
+		return nil, errors.New("endpoint: is required")
+	}
+	if providerOptions.APIKey, err = o.string("apiKey"); err != nil {
+		return nil, err
+	}
+	if providerOptions.Headers, err = o.stringMap("headers"); err != nil {
+		return nil, err
+	}
+	evaluationType, err := o.string("evaluationType")
+	if err != nil {
+		return nil, err
+	}
+	switch gofeatureflag.EvaluationType(evaluationType) {
+	case "":
+	case gofeatureflag.EvaluationTypeInProcess, gofeatureflag.EvaluationTypeRemote:
+		providerOptions.EvaluationType = gofeatureflag.EvaluationType(evaluationType)
+	default:
+		return nil, fmt.Errorf("evaluationType: unknown evaluation type %q, expected one of [INPROCESS REMOTE]", evaluationType)
+	}
+
+	return gofeatureflag.NewProvider(providerOptions)
+}
+
+// factoryOptions are the options of a provider in a configuration file. Errors name the option, the multi-provider
+// prefixes them with the path of the provider.
+type factoryOptions map[string]any
+
+// check reports the first option, in alphabetical order, that is not allowed
+func (o factoryOptions) check(allowed ...string) error {
+	for _, key := range slices.Sorted(maps.Keys(o)) {
+		if !slices.Contains(allowed, key) {
+			return fmt.Errorf("%s: unknown option", key)
+		}
+	}
+	return nil
+}
+
+func (o factoryOptions) string(key string) (string, error) {
+	value, present := o[key]
+	if !present || value == nil {
+		return "", nil
+	}
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%s: expected a string, got %T", key, value)
+	}
+	return s, nil
+}
+
+func (o factoryOptions) bool(key string) (bool, error) {
+	value, present := o[key]
+	if !present || value == nil {
+		return false, nil
+	}
+	b, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("%s: expected a boolean, got %T", key, value)
+	}
+	return b, nil
+}
+
+func (o factoryOptions) int(key string) (int, bool, error) {
+	value, present := o[key]
+	if !present || value == nil {
+		return 0, false, nil
+	}
+	switch v := value.(type) {
+	case int:
+		return v, true, nil
+	case float64:
+		if v == float64(int(v)) {
+			return int(v), true, nil
+		}
+	}
+	return 0, false, fmt.Errorf("%s: expected an integer, got %v", key, value)
+}
+
+// duration reads a duration such as `500ms` or `2s`
+func (o factoryOptions) duration(key string) (time.Duration, bool, error) {
+	value, present := o[key]
+	if !present || value == nil {
+		return 0, false, nil
+	}
+	s, ok := value.(string)
+	if !ok {
+		return 0, false, fmt.Errorf("%s: expected a duration such as \"2s\", got %v", key, value)
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, false, fmt.Errorf("%s: invalid duration %q", key, s)
+	}
+	return d, true, nil
+}
+
+func (o factoryOptions) stringMap(key string) (map[string]string, error) {
+	value, present := o[key]
+	if !present || value == nil {
+		return nil, nil
+	}
+	m, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected a mapping, got %T", key, value)
+	}
+	result := make(map[string]string, len(m))
+	for name, v := range m {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s.%s: expected a string, got %T", key, name, v)
+		}
+		result[name] = s
+	}
+	return result, nil
+}
@@ -0,0 +1,88 @@
+package factories
+
+import (
+	"errors"
+	"testing"
+
+	multiprovider "github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegister(t *testing.T) {
+	registry := multiprovider.NewProviderRegistry()
+	require.NoError(t, Register(registry))
+	assert.Equal(t, []string{TypeFlagd, TypeFromEnv, TypeGoFeatureFlag, TypeOFREP}, registry.Types())
+	assert.Error(t, Register(registry), "types can only be registered once")
+}
+
+func TestLoadConfig(t *testing.T) {
+	registry := multiprovider.NewProviderRegistry()
+	require.NoError(t, Register(registry))
+
+	mp, err := multiprovider.LoadConfig([]byte(`
+strategy: first-match
+providers:
+  - name: flagd
+    type: flagd
+    options: {resolver: rpc, host: flagd.internal, port: 8013, selector: app, deadlineMs: 1000}
+  - name: file
+    type: flagd
+    options: {resolver: file, offlineFlagSourcePath: flags.json}
+  - name: vendor
+    type: ofrep
+    options: {baseUri: "https://flags.vendor.example", bearerToken: secret, headers: {X-Tenant: acme}, timeout: 2s}
+  - name: env
+    type: from-env
+  - name: goff
+    type: go-feature-flag
+    options: {endpoint: "http://goff.internal:1031", evaluationType: REMOTE}
+`), registry)
+	require.NoError(t, err)
+	assert.Len(t, mp.Providers(), 5)
+}
+
+func TestFactories_Errors(t *testing.T) {
+	tests := map[string]struct {
+		factory func(map[string]any) error
+		options map[string]any
+		text    string
+	}{
+		"flagd unknown option":        {factory: discard(Flagd), options: map[string]any{"hostname": "x"}, text: "hostname: unknown option"},
+		"flagd unknown resolver":      {factory: discard(Flagd), options: map[string]any{"resolver": "grpc"}, text: `unknown resolver "grpc"`},
+		"flagd port out of range":     {factory: discard(Flagd), options: map[string]any{"port": 70000}, text: "port: must be between"},
+		"flagd wrong port type":       {factory: discard(Flagd), options: map[string]any{"port": "8013"}, text: "port: expected an integer"},
+		"flagd file without path":     {factory: discard(Flagd), options: map[string]any{"resolver": "file"}, text: "OfflineFlagSourcePath"},
+		"ofrep without base uri":      {factory: discard(OFREP), options: map[string]any{}, text: "baseUri: is required"},
+		"ofrep invalid timeout":       {factory: discard(OFREP), options: map[string]any{"baseUri": "http://x", "timeout": "soon"}, text: `timeout: invalid duration "soon"`},
+		"ofrep invalid header":        {factory: discard(OFREP), options: map[string]any{"baseUri": "http://x", "headers": map[string]any{"X-Id": 1}}, text: "headers.X-Id: expected a string"},
+		"from-env with options":       {factory: discard(FromEnv), options: map[string]any{"prefix": "FLAG_"}, text: "prefix: unknown option"},
+		"go-feature-flag no endpoint": {factory: discard(GoFeatureFlag), options: map[string]any{}, text: "endpoint: is required"},
+		"go-feature-flag evaluation":  {factory: discard(GoFeatureFlag), options: map[string]any{"endpoint": "http://x", "evaluationType": "LOCAL"}, text: `unknown evaluation type "LOCAL"`},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.factory(test.options)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.text)
+		})
+	}
+}
+
+func TestLoadConfig_OptionErrorNamesProvider(t *testing.T) {
+	registry := multiprovider.NewProviderRegistry()
+	require.NoError(t, Register(registry))
+
+	_, err := multiprovider.LoadConfig([]byte("strategy: first-match\nproviders: [{name: vendor, type: ofrep}]"), registry)
+	var configErr *multiprovider.ConfigError
+	require.True(t, errors.As(err, &configErr), "expected a ConfigError, got %v", err)
+	assert.Equal(t, "providers[0].options", configErr.Field)
+	assert.ErrorContains(t, err, "baseUri: is required")
+}
+
+func discard[P any](factory func(map[string]any) (P, error)) func(map[string]any) error {
+	return func(options map[string]any) error {
+		_, err := factory(options)
+		return err
+	}
+}
@@ -0,0 +1,137 @@
+// Package otel provides an OpenTelemetry strategies.Observer publishing metrics about the internal providers of a
+// MultiProvider.
+package otel
+
+import (
+	"context"
+
+	"github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg/strategies"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// ScopeName is the instrumentation scope name.
+	ScopeName = "github.com/open-feature/go-sdk-contrib/providers/multi-provider/otel"
+
+	providerDuration    = "feature_flag.multi_provider.provider.duration"
+	providerEvaluations = "feature_flag.multi_provider.provider.evaluation_total"
+	providerErrors      = "feature_flag.multi_provider.provider.evaluation_error_total"
+	providerTimeouts    = "feature_flag.multi_provider.provider.evaluation_timeout_total"
+	providerSelections  = "feature_flag.multi_provider.provider.selected_total"
+
+	// AttributeProviderName Name of the internal provider
+	AttributeProviderName = attribute.Key("feature_flag.multi_provider.provider_name")
+	// AttributeStrategy Strategy the internal provider was evaluated by
+	AttributeStrategy = attribute.Key("feature_flag.multi_provider.strategy")
+	// AttributeFlagKey Key of the evaluated flag
+	AttributeFlagKey = attribute.Key("feature_flag.key")
+	// AttributeErrorCode Error code returned by the internal provider, only set on the error counter
+	AttributeErrorCode = attribute.Key("error.type")
+	// AttributeSelected Whether the result of the internal provider was returned, only set on the duration histogram
+	AttributeSelected = attribute.Key("feature_flag.multi_provider.selected")
+)
+
+// Observer publishes the duration of every evaluation of an internal provider as a histogram, along with counters of
+// the evaluations, errors, timeouts & selections per provider
+type Observer struct {
+	duration    metric.Float64Histogram
+	evaluations metric.Int64Counter
+	errors      metric.Int64Counter
+	timeouts    metric.Int64Counter
+	selections  metric.Int64Counter
+	withFlagKey bool
+}
+
+// ObserverOption Function used for configuring the Observer
+type ObserverOption func(*Observer)
+
+var _ strategies.Observer = (*Observer)(nil)
+
+// WithoutFlagKey Omits the flag key attribute, limiting the cardinality of the metrics if many flags are evaluated
+func WithoutFlagKey() ObserverOption {
+	return func(o *Observer) {
+		o.withFlagKey = false
+	}
+}
+
+// NewObserver builds an observer backed by the globally set [metric.MeterProvider].
+// Use [otel.SetMeterProvider] to set the global provider or use [NewObserverForProvider].
+func NewObserver(opts ...ObserverOption) (*Observer, error) {
+	return NewObserverForProvider(otel.GetMeterProvider(), opts...)
+}
+
+// NewObserverForProvider builds an observer backed by [metric.MeterProvider].
+func NewObserverForProvider(provider metric.MeterProvider, opts ...ObserverOption) (*Observer, error) {
+	meter := provider.Meter(ScopeName)
+
+	duration, err := meter.Float64Histogram(providerDuration,
+		metric.WithDescription("duration of the evaluations of an internal provider"), metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	evaluations, err := meter.Int64Counter(providerEvaluations, metric.WithDescription("internal provider evaluation counter"))
+	if err != nil {
+		return nil, err
+	}
+
+	errorCounter, err := meter.Int64Counter(providerErrors, metric.WithDescription("internal provider evaluation error counter"))
+	if err != nil {
+		return nil, err
+	}
+
+	timeouts, err := meter.Int64Counter(providerTimeouts, metric.WithDescription("internal provider evaluation timeout counter"))
+	if err != nil {
+		return nil, err
+	}
+
+	selections, err := meter.Int64Counter(providerSelections, metric.WithDescription("internal provider result selected counter"))
+	if err != nil {
+		return nil, err
+	}
+
+	o := &Observer{
+		duration:    duration,
+		evaluations: evaluations,
+		errors:      errorCounter,
+		timeouts:    timeouts,
+		selections:  selections,
+		withFlagKey: true,
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o, nil
+}
+
+// Observe records the observation. The context may already be cancelled, e.g. for shadow providers, so it is only used
+// to pass on its values.
+func (o *Observer) Observe(ctx context.Context, observation strategies.Observation) {
+	ctx = context.WithoutCancel(ctx)
+
+	attrs := []attribute.KeyValue{
+		AttributeProviderName.String(observation.ProviderName),
+		AttributeStrategy.String(observation.Strategy),
+	}
+	if o.withFlagKey {
+		attrs = append(attrs, AttributeFlagKey.String(observation.FlagKey))
+	}
+	common := metric.WithAttributes(attrs...)
+
+	o.duration.Record(ctx, observation.Duration.Seconds(), common,
+		metric.WithAttributes(AttributeSelected.Bool(observation.Selected)))
+	o.evaluations.Add(ctx, 1, common)
+	if observation.ErrorCode != "" {
+		o.errors.Add(ctx, 1, common, metric.WithAttributes(AttributeErrorCode.String(string(observation.ErrorCode))))
+	}
+	if observation.TimedOut {
+		o.timeouts.Add(ctx, 1, common)
+	}
+	if observation.Selected {
+		o.selections.Add(ctx, 1, common)
+	}
+}
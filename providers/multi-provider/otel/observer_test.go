@@ -0,0 +1,105 @@
+package otel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg/strategies"
+	of "github.com/open-feature/go-sdk/openfeature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func collect(t *testing.T, reader *metric.ManualReader) map[string]metricdata.Aggregation {
+	t.Helper()
+	var data metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &data))
+	require.Len(t, data.ScopeMetrics, 1)
+	assert.Equal(t, ScopeName, data.ScopeMetrics[0].Scope.Name)
+
+	metrics := make(map[string]metricdata.Aggregation)
+	for _, m := range data.ScopeMetrics[0].Metrics {
+		metrics[m.Name] = m.Data
+	}
+	return metrics
+}
+
+func TestObserver(t *testing.T) {
+	reader := metric.NewManualReader()
+	observer, err := NewObserverForProvider(metric.NewMeterProvider(metric.WithReader(reader)))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	observer.Observe(ctx, strategies.Observation{
+		Strategy:     strategies.StrategyFirstSuccess,
+		ProviderName: "flagd",
+		FlagKey:      "flag",
+		Duration:     20 * time.Millisecond,
+		Selected:     true,
+	})
+	observer.Observe(ctx, strategies.Observation{
+		Strategy:     strategies.StrategyFirstSuccess,
+		ProviderName: "vendor",
+		FlagKey:      "flag",
+		Duration:     time.Second,
+		ErrorCode:    of.GeneralCode,
+		TimedOut:     true,
+	})
+
+	metrics := collect(t, reader)
+	require.Len(t, metrics, 5)
+
+	duration, ok := metrics[providerDuration].(metricdata.Histogram[float64])
+	require.True(t, ok)
+	require.Len(t, duration.DataPoints, 2)
+	for _, dp := range duration.DataPoints {
+		name, _ := dp.Attributes.Value(AttributeProviderName)
+		selected, _ := dp.Attributes.Value(AttributeSelected)
+		assert.Equal(t, name.AsString() == "flagd", selected.AsBool())
+		assert.Equal(t, uint64(1), dp.Count)
+	}
+
+	evaluations, ok := metrics[providerEvaluations].(metricdata.Sum[int64])
+	require.True(t, ok)
+	assert.Len(t, evaluations.DataPoints, 2)
+
+	errs, ok := metrics[providerErrors].(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, errs.DataPoints, 1)
+	assert.Equal(t, attribute.NewSet(
+		AttributeProviderName.String("vendor"),
+		AttributeStrategy.String(strategies.StrategyFirstSuccess),
+		AttributeFlagKey.String("flag"),
+		AttributeErrorCode.String(string(of.GeneralCode)),
+	), errs.DataPoints[0].Attributes)
+
+	timeouts, ok := metrics[providerTimeouts].(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, timeouts.DataPoints, 1)
+	assert.Equal(t, int64(1), timeouts.DataPoints[0].Value)
+
+	selections, ok := metrics[providerSelections].(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, selections.DataPoints, 1)
+	name, _ := selections.DataPoints[0].Attributes.Value(AttributeProviderName)
+	assert.Equal(t, "flagd", name.AsString())
+}
+
+func TestObserver_WithoutFlagKey(t *testing.T) {
+	reader := metric.NewManualReader()
+	observer, err := NewObserverForProvider(metric.NewMeterProvider(metric.WithReader(reader)), WithoutFlagKey())
+	require.NoError(t, err)
+
+	observer.Observe(context.Background(), strategies.Observation{ProviderName: "flagd", FlagKey: "flag"})
+
+	evaluations, ok := collect(t, reader)[providerEvaluations].(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, evaluations.DataPoints, 1)
+	_, present := evaluations.DataPoints[0].Attributes.Value(AttributeFlagKey)
+	assert.False(t, present)
+}
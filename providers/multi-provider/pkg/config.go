@@ -0,0 +1,618 @@
+package multiprovider
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg/strategies"
+	of "github.com/open-feature/go-sdk/openfeature"
+	"gopkg.in/yaml.v3"
+)
+
+type (
+	// ProviderFactory Creates a provider from the options set for it in a configuration file. Options is never nil.
+	ProviderFactory func(options map[string]any) (of.FeatureProvider, error)
+
+	// ProviderRegistry Maps the provider types used in configuration files, e.g. `flagd` or `ofrep`, to the factories
+	// creating them. It is safe for concurrent use.
+	ProviderRegistry struct {
+		mu        sync.RWMutex
+		factories map[string]ProviderFactory
+	}
+
+	// ConfigError Reports an invalid entry of a configuration file. Field is the path of the entry, e.g.
+	// `providers[1].type` or `flagKeyRouting.routes[0].provider`.
+	ConfigError struct {
+		Field string
+		Err   error
+	}
+)
+
+var (
+	_ error = (*ConfigError)(nil)
+
+	// configStrategies Maps the strategy names used in configuration files to the evaluation strategies
+	configStrategies = map[string]EvaluationStrategy{
+		"first-match":      StrategyFirstMatch,
+		"first-success":    StrategyFirstSuccess,
+		"comparison":       StrategyComparison,
+		"shadow":           StrategyShadow,
+		"flag-key-routing": StrategyFlagKeyRouting,
+		"context-routing":  StrategyContextRouting,
+		"voting":           StrategyVoting,
+	}
+
+	// configStrategySections Maps the top level sections used by some strategies only to the strategies using them
+	configStrategySections = map[string][]EvaluationStrategy{
+		"hedgeDelay":       {StrategyFirstSuccess},
+		"fallbackProvider": {StrategyComparison},
+		"flagKeyRouting":   {StrategyFlagKeyRouting},
+		"contextRouting":   {StrategyContextRouting},
+		"quorum":           {StrategyVoting},
+		"weights":          {StrategyVoting},
+	}
+)
+
+func (e *ConfigError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Err.Error())
+}
+
+func (e *ConfigError) Unwrap() error {
+	return e.Err
+}
+
+// NewProviderRegistry Creates an empty ProviderRegistry. No provider types are built in, not even `flagd` or `ofrep`, as
+// that would make this module depend on every provider. The application registers the types it uses, e.g. via the
+// Register function of the multi-provider/factories module.
+func NewProviderRegistry() *ProviderRegistry {
+	return &ProviderRegistry{factories: make(map[string]ProviderFactory)}
+}
+
+// Register Registers the factory for the provider type. Each type can only be registered once.
+func (r *ProviderRegistry) Register(providerType string, factory ProviderFactory) error {
+	if providerType == "" {
+		return errors.New("provider type cannot be the empty string")
+	}
+	if factory == nil {
+		return fmt.Errorf("factory of provider type %s cannot be nil", providerType)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.factories[providerType]; exists {
+		return fmt.Errorf("provider type %s is already registered", providerType)
+	}
+	r.factories[providerType] = factory
+	return nil
+}
+
+// Types Returns the registered provider types in alphabetical order
+func (r *ProviderRegistry) Types() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Sorted(maps.Keys(r.factories))
+}
+
+func (r *ProviderRegistry) factory(providerType string) (ProviderFactory, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	f, ok := r.factories[providerType]
+	return f, ok
+}
+
+// LoadConfigFile Reads the YAML or JSON configuration file at the path and creates the MultiProvider it describes, see
+// LoadConfig.
+func LoadConfigFile(path string, registry *ProviderRegistry, options ...Option) (*MultiProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return LoadConfig(data, registry, options...)
+}
+
+// LoadConfig Creates the MultiProvider described by a YAML or JSON configuration. The internal providers are created in
+// the order they are listed, using the factory registered for their type. Options set in code are applied after the
+// ones derived from the configuration, so they take precedence. Invalid entries are reported as a *ConfigError naming
+// the exact field. The providers already created are shut down when the configuration turns out to be invalid.
+func LoadConfig(data []byte, registry *ProviderRegistry, options ...Option) (_ *MultiProvider, err error) {
+	if registry == nil {
+		return nil, errors.New("provider registry cannot be nil")
+	}
+
+	var raw any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	root, err := configMap("", raw)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkConfigKeys("", root, "providers", "strategy", "timeout", "hedgeDelay", "fallbackProvider",
+		"flagKeyRouting", "contextRouting", "quorum", "weights", "readiness", "initTimeout", "initRetryInterval",
+		"eventPublishing"); err != nil {
+		return nil, err
+	}
+
+	strategyName, err := configString("strategy", root["strategy"], true)
+	if err != nil {
+		return nil, err
+	}
+	strategy, ok := configStrategies[strategyName]
+	if !ok {
+		return nil, &ConfigError{Field: "strategy", Err: fmt.Errorf("unknown strategy %q, expected one of %v",
+			strategyName, slices.Sorted(maps.Keys(configStrategies)))}
+	}
+	// a section the strategy never reads is most likely a mistake, e.g. routes set for the first-match strategy
+	for _, section := range slices.Sorted(maps.Keys(configStrategySections)) {
+		if _, present := root[section]; present && !slices.Contains(configStrategySections[section], strategy) {
+			return nil, &ConfigError{Field: section, Err: fmt.Errorf("is not used by the %s strategy", strategyName)}
+		}
+	}
+
+	providers, initTimeouts, err := loadConfigProviders(root, registry)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			shutdownConfigProviders(providers)
+		}
+	}()
+	byName := make(ProviderMap, len(providers))
+	for _, p := range providers {
+		byName[p.Name] = p.Provider
+	}
+
+	opts := make([]Option, 0, len(options)+8)
+	for _, field := range []struct {
+		name  string
+		apply func(time.Duration) Option
+	}{
+		{"timeout", WithTimeout},
+		{"hedgeDelay", WithHedgeDelay},
+		{"initTimeout", WithInitTimeout},
+		{"initRetryInterval", WithInitRetryInterval},
+	} {
+		if value, present := root[field.name]; present {
+			d, err := configDuration(field.name, value)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, field.apply(d))
+		}
+	}
+
+	if len(initTimeouts) > 0 {
+		opts = append(opts, WithProviderInitTimeouts(initTimeouts))
+	}
+
+	if value, present := root["fallbackProvider"]; present {
+		name, err := configProviderName("fallbackProvider", value, byName)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithFallbackProvider(byName[name]))
+	}
+
+	if value, present := root["flagKeyRouting"]; present {
+		opt, err := loadConfigFlagKeyRouting(value, byName)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, opt)
+	} else if strategy == StrategyFlagKeyRouting {
+		return nil, &ConfigError{Field: "flagKeyRouting", Err: fmt.Errorf("is required by the %s strategy", strategyName)}
+	}
+
+	if value, present := root["contextRouting"]; present {
+		opt, err := loadConfigContextRouting(value, byName)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, opt)
+	} else if strategy == StrategyContextRouting {
+		return nil, &ConfigError{Field: "contextRouting", Err: fmt.Errorf("is required by the %s strategy", strategyName)}
+	}
+
+	// providers without a weight have a weight of 1
+	totalWeight := len(providers)
+	if value, present := root["weights"]; present {
+		m, err := configMap("weights", value)
+		if err != nil {
+			return nil, err
+		}
+		weights := make(map[string]int, len(m))
+		for _, name := range slices.Sorted(maps.Keys(m)) {
+			field := "weights." + name
+			if _, known := byName[name]; !known {
+				return nil, &ConfigError{Field: field, Err: fmt.Errorf("unknown provider %q", name)}
+			}
+			if weights[name], err = configInt(field, m[name]); err != nil {
+				return nil, err
+			}
+			if weights[name] <= 0 {
+				return nil, &ConfigError{Field: field, Err: errors.New("must be positive")}
+			}
+			totalWeight += weights[name] - 1
+		}
+		opts = append(opts, WithProviderWeights(weights))
+	}
+
+	if value, present := root["quorum"]; present {
+		quorum, err := configInt("quorum", value)
+		if err != nil {
+			return nil, err
+		}
+		if quorum <= 0 || quorum > totalWeight {
+			return nil, &ConfigError{Field: "quorum", Err: fmt.Errorf("must be between 1 and the total weight %d of all providers", totalWeight)}
+		}
+		opts = append(opts, WithQuorum(quorum))
+	}
+
+	if value, present := root["readiness"]; present {
+		policy, err := loadConfigReadiness(value, byName)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithReadinessPolicy(policy))
+	}
+
+	if value, present := root["eventPublishing"]; present {
+		enabled, ok := value.(bool)
+		if !ok {
+			return nil, &ConfigError{Field: "eventPublishing", Err: fmt.Errorf("expected a boolean, got %T", value)}
+		}
+		if enabled {
+			opts = append(opts, WithEventPublishing())
+		}
+	}
+
+	return NewOrderedMultiProvider(providers, strategy, append(opts, options...)...)
+}
+
+// loadConfigProviders creates the providers listed in the configuration, returning them along with the init timeouts set
+// for individual providers
+func loadConfigProviders(root map[string]any, registry *ProviderRegistry) (_ []*strategies.NamedProvider, _ map[string]time.Duration, err error) {
+	value, present := root["providers"]
+	if !present {
+		return nil, nil, &ConfigError{Field: "providers", Err: errors.New("is required")}
+	}
+	list, ok := value.([]any)
+	if !ok {
+		return nil, nil, &ConfigError{Field: "providers", Err: fmt.Errorf("expected a list, got %T", value)}
+	}
+	if len(list) == 0 {
+		return nil, nil, &ConfigError{Field: "providers", Err: errors.New("cannot be empty")}
+	}
+
+	providers := make([]*strategies.NamedProvider, 0, len(list))
+	initTimeouts := make(map[string]time.Duration)
+	seen := make(map[string]bool, len(list))
+	defer func() {
+		if err != nil {
+			shutdownConfigProviders(providers)
+		}
+	}()
+	for i, entry := range list {
+		path := fmt.Sprintf("providers[%d]", i)
+		m, err := configMap(path, entry)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := checkConfigKeys(path, m, "name", "type", "options", "initTimeout"); err != nil {
+			return nil, nil, err
+		}
+
+		name, err := configString(path+".name", m["name"], true)
+		if err != nil {
+			return nil, nil, err
+		}
+		if seen[name] {
+			return nil, nil, &ConfigError{Field: path + ".name", Err: fmt.Errorf("provider name %s is used more than once", name)}
+		}
+		seen[name] = true
+
+		if value, present := m["initTimeout"]; present {
+			if initTimeouts[name], err = configDuration(path+".initTimeout", value); err != nil {
+				return nil, nil, err
+			}
+			if initTimeouts[name] < 0 {
+				return nil, nil, &ConfigError{Field: path + ".initTimeout", Err: errors.New("cannot be negative")}
+			}
+		}
+
+		providerType, err := configString(path+".type", m["type"], true)
+		if err != nil {
+			return nil, nil, err
+		}
+		factory, ok := registry.factory(providerType)
+		if !ok {
+			return nil, nil, &ConfigError{Field: path + ".type", Err: fmt.Errorf("unknown provider type %q, registered types are %v",
+				providerType, registry.Types())}
+		}
+
+		providerOptions := map[string]any{}
+		if value, present := m["options"]; present && value != nil {
+			if providerOptions, err = configMap(path+".options", value); err != nil {
+				return nil, nil, err
+			}
+		}
+		provider, err := factory(providerOptions)
+		if err != nil {
+			return nil, nil, &ConfigError{Field: path + ".options", Err: err}
+		}
+		if provider == nil {
+			return nil, nil, &ConfigError{Field: path + ".type", Err: fmt.Errorf("factory of provider type %s returned nil", providerType)}
+		}
+		providers = append(providers, &strategies.NamedProvider{Name: name, Provider: provider})
+	}
+	return providers, initTimeouts, nil
+}
+
+// shutdownConfigProviders shuts down the providers created for a configuration that turned out to be invalid
+func shutdownConfigProviders(providers []*strategies.NamedProvider) {
+	for _, p := range providers {
+		if handler, ok := p.Provider.(of.StateHandler); ok {
+			handler.Shutdown()
+		}
+	}
+}
+
+// loadConfigFlagKeyRouting reads the `flagKeyRouting` section. Each route matches flag keys using exactly one of the
+// `exact`, `prefix`, `glob` or `regex` fields.
+func loadConfigFlagKeyRouting(value any, byName ProviderMap) (Option, error) {
+	m, err := configMap("flagKeyRouting", value)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkConfigKeys("flagKeyRouting", m, "defaultProvider", "routes"); err != nil {
+		return nil, err
+	}
+
+	defaultProvider, err := configOptionalProviderName("flagKeyRouting.defaultProvider", m["defaultProvider"], byName)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []any
+	if value := m["routes"]; value != nil {
+		var ok bool
+		if list, ok = value.([]any); !ok {
+			return nil, &ConfigError{Field: "flagKeyRouting.routes", Err: fmt.Errorf("expected a list, got %T", value)}
+		}
+	}
+
+	if len(list) == 0 && defaultProvider == "" {
+		// no flag would ever be routed to a provider
+		return nil, &ConfigError{Field: "flagKeyRouting", Err: errors.New("must set routes, a defaultProvider or both")}
+	}
+
+	routes := make([]strategies.FlagKeyRoute, 0, len(list))
+	for i, entry := range list {
+		path := fmt.Sprintf("flagKeyRouting.routes[%d]", i)
+		route, err := configMap(path, entry)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkConfigKeys(path, route, "exact", "prefix", "glob", "regex", "provider"); err != nil {
+			return nil, err
+		}
+		provider, err := configProviderName(path+".provider", route["provider"], byName)
+		if err != nil {
+			return nil, err
+		}
+
+		var matchers []string
+		for _, key := range []string{"exact", "prefix", "glob", "regex"} {
+			if _, present := route[key]; present {
+				matchers = append(matchers, key)
+			}
+		}
+		if len(matchers) != 1 {
+			return nil, &ConfigError{Field: path, Err: errors.New("exactly one of exact, prefix, glob or regex must be set")}
+		}
+		field := path + "." + matchers[0]
+		pattern, err := configString(field, route[matchers[0]], true)
+		if err != nil {
+			return nil, err
+		}
+
+		var r strategies.FlagKeyRoute
+		switch matchers[0] {
+		case "exact":
+			r = strategies.ExactKeyRoute(pattern, provider)
+		case "prefix":
+			r = strategies.PrefixKeyRoute(pattern, provider)
+		case "glob":
+			r, err = strategies.GlobKeyRoute(pattern, provider)
+		case "regex":
+			r, err = strategies.RegexKeyRoute(pattern, provider)
+		}
+		if err != nil {
+			return nil, &ConfigError{Field: field, Err: err}
+		}
+		routes = append(routes, r)
+	}
+
+	return WithFlagKeyRoutes(defaultProvider, routes...), nil
+}
+
+// loadConfigContextRouting reads the `contextRouting` section
+func loadConfigContextRouting(value any, byName ProviderMap) (Option, error) {
+	m, err := configMap("contextRouting", value)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkConfigKeys("contextRouting", m, "attribute", "defaultProvider", "routes"); err != nil {
+		return nil, err
+	}
+
+	attribute, err := configString("contextRouting.attribute", m["attribute"], true)
+	if err != nil {
+		return nil, err
+	}
+	defaultProvider, err := configOptionalProviderName("contextRouting.defaultProvider", m["defaultProvider"], byName)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make(map[string]string)
+	if value, present := m["routes"]; present && value != nil {
+		rm, err := configMap("contextRouting.routes", value)
+		if err != nil {
+			return nil, err
+		}
+		for _, attributeValue := range slices.Sorted(maps.Keys(rm)) {
+			name, err := configProviderName("contextRouting.routes."+attributeValue, rm[attributeValue], byName)
+			if err != nil {
+				return nil, err
+			}
+			routes[attributeValue] = name
+		}
+	}
+
+	return WithContextRoutes(attribute, defaultProvider, routes), nil
+}
+
+// loadConfigReadiness reads the `readiness` section, e.g. `{policy: primary, primary: flagd}`
+func loadConfigReadiness(value any, byName ProviderMap) (ReadinessPolicy, error) {
+	m, err := configMap("readiness", value)
+	if err != nil {
+		return ReadinessPolicy{}, err
+	}
+	if err := checkConfigKeys("readiness", m, "policy", "primary", "count"); err != nil {
+		return ReadinessPolicy{}, err
+	}
+
+	policy, err := configString("readiness.policy", m["policy"], true)
+	if err != nil {
+		return ReadinessPolicy{}, err
+	}
+	_, hasPrimary := m["primary"]
+	_, hasCount := m["count"]
+	switch {
+	case policy != "primary" && hasPrimary:
+		return ReadinessPolicy{}, &ConfigError{Field: "readiness.primary", Err: errors.New("is only supported by the primary policy")}
+	case policy != "count" && hasCount:
+		return ReadinessPolicy{}, &ConfigError{Field: "readiness.count", Err: errors.New("is only supported by the count policy")}
+	}
+
+	switch policy {
+	case "all":
+		return ReadyWhenAll(), nil
+	case "any":
+		return ReadyWhenAny(), nil
+	case "primary":
+		name, err := configProviderName("readiness.primary", m["primary"], byName)
+		if err != nil {
+			return ReadinessPolicy{}, err
+		}
+		return ReadyWhenPrimary(name), nil
+	case "count":
+		n, err := configInt("readiness.count", m["count"])
+		if err != nil {
+			return ReadinessPolicy{}, err
+		}
+		if n <= 0 || n > len(byName) {
+			return ReadinessPolicy{}, &ConfigError{Field: "readiness.count", Err: fmt.Errorf("must be between 1 and %d", len(byName))}
+		}
+		return ReadyWhenCount(n), nil
+	default:
+		return ReadinessPolicy{}, &ConfigError{Field: "readiness.policy", Err: fmt.Errorf("unknown policy %q, expected one of [all any count primary]", policy)}
+	}
+}
+
+// checkConfigKeys reports the first key of the map, in alphabetical order, that is not allowed
+func checkConfigKeys(path string, m map[string]any, allowed ...string) error {
+	for _, key := range slices.Sorted(maps.Keys(m)) {
+		if !slices.Contains(allowed, key) {
+			return &ConfigError{Field: joinConfigPath(path, key), Err: errors.New("unknown field")}
+		}
+	}
+	return nil
+}
+
+func joinConfigPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func configMap(path string, value any) (map[string]any, error) {
+	m, ok := value.(map[string]any)
+	if !ok {
+		if path == "" {
+			return nil, fmt.Errorf("invalid configuration: expected a mapping, got %T", value)
+		}
+		return nil, &ConfigError{Field: path, Err: fmt.Errorf("expected a mapping, got %T", value)}
+	}
+	return m, nil
+}
+
+func configString(path string, value any, required bool) (string, error) {
+	if value == nil {
+		if required {
+			return "", &ConfigError{Field: path, Err: errors.New("is required")}
+		}
+		return "", nil
+	}
+	s, ok := value.(string)
+	if !ok {
+		return "", &ConfigError{Field: path, Err: fmt.Errorf("expected a string, got %T", value)}
+	}
+	if required && s == "" {
+		return "", &ConfigError{Field: path, Err: errors.New("cannot be the empty string")}
+	}
+	return s, nil
+}
+
+func configInt(path string, value any) (int, error) {
+	switch v := value.(type) {
+	case int:
+		return v, nil
+	case float64:
+		if v == float64(int(v)) {
+			return int(v), nil
+		}
+	}
+	return 0, &ConfigError{Field: path, Err: fmt.Errorf("expected an integer, got %v", value)}
+}
+
+// configDuration reads a duration such as `500ms` or `2s`
+func configDuration(path string, value any) (time.Duration, error) {
+	s, ok := value.(string)
+	if !ok {
+		return 0, &ConfigError{Field: path, Err: fmt.Errorf("expected a duration such as \"2s\", got %v", value)}
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, &ConfigError{Field: path, Err: fmt.Errorf("invalid duration %s", strconv.Quote(s))}
+	}
+	return d, nil
+}
+
+// configProviderName reads the name of a provider listed in the configuration
+func configProviderName(path string, value any, byName ProviderMap) (string, error) {
+	name, err := configString(path, value, true)
+	if err != nil {
+		return "", err
+	}
+	if _, known := byName[name]; !known {
+		return "", &ConfigError{Field: path, Err: fmt.Errorf("unknown provider %q", name)}
+	}
+	return name, nil
+}
+
+func configOptionalProviderName(path string, value any, byName ProviderMap) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	return configProviderName(path, value, byName)
+}
@@ -0,0 +1,259 @@
+package multiprovider
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+	imp "github.com/open-feature/go-sdk/openfeature/memprovider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRegistry registers the `memory` type, creating an InMemoryProvider with a single boolean flag whose value is
+// set via the `value` option
+func newTestRegistry(t *testing.T) *ProviderRegistry {
+	t.Helper()
+	registry := NewProviderRegistry()
+	require.NoError(t, registry.Register("memory", func(options map[string]any) (of.FeatureProvider, error) {
+		value, ok := options["value"].(bool)
+		if !ok {
+			return nil, errors.New("value must be a boolean")
+		}
+		return imp.NewInMemoryProvider(map[string]imp.InMemoryFlag{
+			"flag": {
+				State:          imp.Enabled,
+				DefaultVariant: "default",
+				Variants:       map[string]any{"default": value},
+			},
+		}), nil
+	}))
+	return registry
+}
+
+func TestProviderRegistry(t *testing.T) {
+	registry := newTestRegistry(t)
+	factory := func(map[string]any) (of.FeatureProvider, error) { return imp.NewInMemoryProvider(nil), nil }
+
+	require.NoError(t, registry.Register("flagd", factory))
+	assert.ErrorContains(t, registry.Register("flagd", factory), "already registered")
+	assert.Error(t, registry.Register("", factory))
+	assert.Error(t, registry.Register("ofrep", nil))
+	assert.Equal(t, []string{"flagd", "memory"}, registry.Types())
+}
+
+func TestLoadConfig(t *testing.T) {
+	t.Run("yaml", func(t *testing.T) {
+		config := `
+strategy: flag-key-routing
+timeout: 2s
+initTimeout: 500ms
+readiness:
+  policy: primary
+  primary: primary
+flagKeyRouting:
+  defaultProvider: primary
+  routes:
+    - prefix: vendor-
+      provider: vendor
+    - regex: "^exp-[0-9]+$"
+      provider: vendor
+providers:
+  - name: primary
+    type: memory
+    options:
+      value: true
+  - name: vendor
+    type: memory
+    initTimeout: 2s
+    options:
+      value: false
+`
+		mp, err := LoadConfig([]byte(config), newTestRegistry(t))
+		require.NoError(t, err)
+		assert.Equal(t, "MultiProvider {primary: InMemoryProvider, vendor: InMemoryProvider}", mp.Metadata().Name)
+		assert.Equal(t, StrategyFlagKeyRouting, mp.EvaluationStrategy())
+		require.NoError(t, mp.Init(of.EvaluationContext{}))
+		defer mp.Shutdown()
+
+		assert.True(t, mp.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{}).Value)
+		assert.Equal(t, ReadyWhenPrimary("primary"), *mp.config.readinessPolicy)
+		assert.Equal(t, map[string]time.Duration{"vendor": 2 * time.Second}, mp.config.providerInitTimeouts)
+	})
+
+	t.Run("json file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "multi-provider.json")
+		config := `{
+			"strategy": "comparison",
+			"fallbackProvider": "b",
+			"eventPublishing": true,
+			"providers": [
+				{"name": "a", "type": "memory", "options": {"value": true}},
+				{"name": "b", "type": "memory", "options": {"value": false}}
+			]
+		}`
+		require.NoError(t, os.WriteFile(path, []byte(config), 0o600))
+
+		mp, err := LoadConfigFile(path, newTestRegistry(t))
+		require.NoError(t, err)
+		assert.NotNil(t, mp.EventChannel())
+		require.NoError(t, mp.Init(of.EvaluationContext{}))
+		defer mp.Shutdown()
+
+		// the providers disagree, so the fallback provider decides
+		assert.False(t, mp.BooleanEvaluation(context.Background(), "flag", true, of.FlattenedContext{}).Value)
+	})
+
+	t.Run("options set in code take precedence", func(t *testing.T) {
+		config := `{"strategy": "first-match", "eventPublishing": true, "providers": [{"name": "a", "type": "memory", "options": {"value": true}}]}`
+		mp, err := LoadConfig([]byte(config), newTestRegistry(t), WithoutEventPublishing())
+		require.NoError(t, err)
+		assert.False(t, mp.config.publishEvents)
+	})
+}
+
+func TestLoadConfig_Errors(t *testing.T) {
+	const providers = `
+providers:
+  - name: a
+    type: memory
+    options: {value: true}
+  - name: b
+    type: memory
+    options: {value: false}
+`
+	tests := map[string]struct {
+		config string
+		field  string
+		text   string
+	}{
+		"missing providers":        {config: "strategy: first-match", field: "providers", text: "is required"},
+		"empty providers":          {config: "strategy: first-match\nproviders: []", field: "providers", text: "cannot be empty"},
+		"unknown top level field":  {config: "strategy: first-match\nstrategies: voting" + providers, field: "strategies", text: "unknown field"},
+		"missing strategy":         {config: providers, field: "strategy", text: "is required"},
+		"unknown strategy":         {config: "strategy: random" + providers, field: "strategy", text: `unknown strategy "random"`},
+		"invalid duration":         {config: "strategy: first-success\ntimeout: soon" + providers, field: "timeout", text: `invalid duration "soon"`},
+		"unknown fallback":         {config: "strategy: comparison\nfallbackProvider: c" + providers, field: "fallbackProvider", text: `unknown provider "c"`},
+		"wrong quorum type":        {config: "strategy: voting\nquorum: two" + providers, field: "quorum", text: "expected an integer"},
+		"weight of unknown":        {config: "strategy: voting\nweights: {c: 2}" + providers, field: "weights.c", text: `unknown provider "c"`},
+		"weight not positive":      {config: "strategy: voting\nweights: {b: 0}" + providers, field: "weights.b", text: "must be positive"},
+		"quorum too high":          {config: "strategy: voting\nquorum: 4\nweights: {a: 2}" + providers, field: "quorum", text: "between 1 and the total weight 3"},
+		"quorum not positive":      {config: "strategy: voting\nquorum: 0" + providers, field: "quorum", text: "between 1 and the total weight 2"},
+		"missing flag key routing": {config: "strategy: flag-key-routing" + providers, field: "flagKeyRouting", text: "is required by the flag-key-routing strategy"},
+		"missing context routing":  {config: "strategy: context-routing" + providers, field: "contextRouting", text: "is required by the context-routing strategy"},
+		"unused flag key routing":  {config: "strategy: first-match\nflagKeyRouting: {defaultProvider: a}" + providers, field: "flagKeyRouting", text: "is not used by the first-match strategy"},
+		"unused context routing":   {config: "strategy: flag-key-routing\ncontextRouting: {attribute: tenant}" + providers, field: "contextRouting", text: "is not used by the flag-key-routing strategy"},
+		"unused hedge delay":       {config: "strategy: first-match\nhedgeDelay: 50ms" + providers, field: "hedgeDelay", text: "is not used by the first-match strategy"},
+		"unused quorum":            {config: "strategy: first-success\nquorum: 1" + providers, field: "quorum", text: "is not used by the first-success strategy"},
+		"unused weights":           {config: "strategy: comparison\nweights: {a: 2}" + providers, field: "weights", text: "is not used by the comparison strategy"},
+		"unused fallback":          {config: "strategy: voting\nfallbackProvider: a" + providers, field: "fallbackProvider", text: "is not used by the voting strategy"},
+		"unknown readiness policy": {config: "strategy: first-match\nreadiness: {policy: some}" + providers, field: "readiness.policy", text: `unknown policy "some"`},
+		"readiness count too high": {config: "strategy: first-match\nreadiness: {policy: count, count: 3}" + providers, field: "readiness.count", text: "between 1 and 2"},
+		"misplaced primary":        {config: "strategy: first-match\nreadiness: {policy: any, primary: a}" + providers, field: "readiness.primary", text: "only supported"},
+		"unknown route provider": {
+			config: "strategy: flag-key-routing\nflagKeyRouting: {routes: [{prefix: x-, provider: a}, {exact: y, provider: c}]}" + providers,
+			field:  "flagKeyRouting.routes[1].provider",
+			text:   `unknown provider "c"`,
+		},
+		"route without matcher": {
+			config: "strategy: flag-key-routing\nflagKeyRouting: {routes: [{provider: a}]}" + providers,
+			field:  "flagKeyRouting.routes[0]",
+			text:   "exactly one of",
+		},
+		"invalid route regex": {
+			config: "strategy: flag-key-routing\nflagKeyRouting: {routes: [{regex: '(', provider: a}]}" + providers,
+			field:  "flagKeyRouting.routes[0].regex",
+			text:   "invalid regular expression",
+		},
+		"unknown context route provider": {
+			config: "strategy: context-routing\ncontextRouting: {attribute: tenant, routes: {acme: c}}" + providers,
+			field:  "contextRouting.routes.acme",
+			text:   `unknown provider "c"`,
+		},
+		"missing routing attribute": {
+			config: "strategy: context-routing\ncontextRouting: {routes: {acme: a}}" + providers,
+			field:  "contextRouting.attribute",
+			text:   "is required",
+		},
+		"unknown provider type": {
+			config: "strategy: first-match\nproviders: [{name: a, type: memory, options: {value: true}}, {name: b, type: flagd}]",
+			field:  "providers[1].type",
+			text:   `unknown provider type "flagd", registered types are [memory]`,
+		},
+		"missing provider name": {
+			config: "strategy: first-match\nproviders: [{type: memory}]",
+			field:  "providers[0].name",
+			text:   "is required",
+		},
+		"duplicate provider name": {
+			config: "strategy: first-match\nproviders: [{name: a, type: memory, options: {value: true}}, {name: a, type: memory}]",
+			field:  "providers[1].name",
+			text:   "used more than once",
+		},
+		"unknown provider field": {
+			config: "strategy: first-match\nproviders: [{name: a, type: memory, option: {value: true}}]",
+			field:  "providers[0].option",
+			text:   "unknown field",
+		},
+		"negative provider init timeout": {
+			config: "strategy: first-match\nproviders: [{name: a, type: memory, initTimeout: -1s}]",
+			field:  "providers[0].initTimeout",
+			text:   "cannot be negative",
+		},
+		"flag key routing without routes": {
+			config: "strategy: flag-key-routing\nflagKeyRouting: {routes: []}" + providers,
+			field:  "flagKeyRouting",
+			text:   "must set routes, a defaultProvider or both",
+		},
+		"factory error": {
+			config: "strategy: first-match\nproviders: [{name: a, type: memory, options: {value: yes please}}]",
+			field:  "providers[0].options",
+			text:   "value must be a boolean",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := LoadConfig([]byte(tt.config), newTestRegistry(t))
+			var configErr *ConfigError
+			require.ErrorAs(t, err, &configErr)
+			assert.Equal(t, tt.field, configErr.Field)
+			assert.ErrorContains(t, err, tt.text)
+		})
+	}
+
+	t.Run("created providers are shut down", func(t *testing.T) {
+		registry := newTestRegistry(t)
+		var created []*lifecycleProvider
+		require.NoError(t, registry.Register("lifecycle", func(map[string]any) (of.FeatureProvider, error) {
+			p := &lifecycleProvider{FeatureProvider: imp.NewInMemoryProvider(nil)}
+			created = append(created, p)
+			return p, nil
+		}))
+
+		// by an invalid provider entry
+		_, err := LoadConfig([]byte("strategy: first-match\nproviders: [{name: a, type: lifecycle}, {name: b, type: flagd}]"), registry)
+		require.Error(t, err)
+		// by an invalid strategy setting
+		_, err = LoadConfig([]byte("strategy: voting\nquorum: 3\nproviders: [{name: a, type: lifecycle}, {name: b, type: lifecycle}]"), registry)
+		require.Error(t, err)
+
+		require.Len(t, created, 3)
+		for _, p := range created {
+			assert.Equal(t, 1, p.shutdowns)
+		}
+	})
+
+	t.Run("invalid document", func(t *testing.T) {
+		_, err := LoadConfig([]byte("- a\n- b"), newTestRegistry(t))
+		assert.ErrorContains(t, err, "expected a mapping")
+		_, err = LoadConfig([]byte("strategy: [first-match"), newTestRegistry(t))
+		assert.ErrorContains(t, err, "invalid configuration")
+		_, err = LoadConfig([]byte("strategy: first-match"), nil)
+		assert.Error(t, err)
+	})
+}
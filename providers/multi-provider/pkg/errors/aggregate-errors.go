@@ -1,19 +1,28 @@
 package errors
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
-	"golang.org/x/exp/maps"
+	"slices"
 	"strings"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+	"golang.org/x/exp/maps"
 )
 
 type (
-	// ProviderError is how the error in the Init stage of a provider is reported.
+	// ProviderError is how the error of a provider is reported, e.g. in the Init stage or when resolving a flag.
 	ProviderError struct {
 		Err          error
 		ProviderName string
+		// order position of the error within the slice passed to NewAggregateError
+		order int
 	}
 
-	// AggregateError map that contains up to one error per provider within the multi-provider
+	// AggregateError map that contains up to one error per provider within the multi-provider. Errors created via
+	// NewAggregateError are reported in the order they were passed in. The errors of the providers can be matched with
+	// errors.Is & errors.As.
 	AggregateError map[string]ProviderError
 )
 
@@ -26,31 +35,95 @@ func (e *ProviderError) Error() string {
 	return fmt.Sprintf("Provider %s: %s", e.ProviderName, e.Err.Error())
 }
 
+// Unwrap Returns the error of the provider
+func (e *ProviderError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorCode Returns the code of the resolution error returned by the provider, or GENERAL if the provider did not
+// return an of.ResolutionError
+func (e *ProviderError) ErrorCode() of.ErrorCode {
+	if detail, ok := e.resolutionDetail(); ok {
+		return detail.ErrorCode
+	}
+	return of.GeneralCode
+}
+
+// ErrorMessage Returns the message of the resolution error returned by the provider without its code, or the message
+// of the error if the provider did not return an of.ResolutionError
+func (e *ProviderError) ErrorMessage() string {
+	if detail, ok := e.resolutionDetail(); ok {
+		return detail.ErrorMessage
+	}
+	return e.Err.Error()
+}
+
+func (e *ProviderError) resolutionDetail() (of.ResolutionDetail, bool) {
+	var rErr of.ResolutionError
+	if !errors.As(e.Err, &rErr) {
+		return of.ResolutionDetail{}, false
+	}
+	detail := of.ProviderResolutionDetail{ResolutionError: rErr}.ResolutionDetail()
+	return detail, detail.ErrorCode != ""
+}
+
 // NewAggregateError Creates a new AggregateError
 func NewAggregateError(providerErrors []ProviderError) *AggregateError {
 	err := make(AggregateError)
-	for _, se := range providerErrors {
+	for i, se := range providerErrors {
+		se.order = i
 		err[se.ProviderName] = se
 	}
 	return &err
 }
 
-func (ae AggregateError) Error() string {
-	size := len(ae)
-	switch size {
-	case 0:
-		return ""
-	case 1:
-		for _, err := range ae {
-			return err.Error()
-		}
-	default:
-		errs := make([]string, 0, size)
-		for _, err := range maps.Values(ae) {
-			errs = append(errs, err.Error())
+// Errors Returns the errors of the providers in the order they were passed to NewAggregateError
+func (ae AggregateError) Errors() []ProviderError {
+	providerErrs := maps.Values(ae)
+	slices.SortFunc(providerErrs, func(a, b ProviderError) int {
+		return cmp.Or(cmp.Compare(a.order, b.order), cmp.Compare(a.ProviderName, b.ProviderName))
+	})
+	return providerErrs
+}
+
+// Unwrap Returns the errors of the providers as *ProviderError in order, so they can be matched by errors.Is &
+// errors.As
+func (ae AggregateError) Unwrap() []error {
+	providerErrs := ae.Errors()
+	errs := make([]error, 0, len(providerErrs))
+	for i := range providerErrs {
+		errs = append(errs, &providerErrs[i])
+	}
+	return errs
+}
+
+// ErrorCode Returns the error code shared by the errors of all providers, or GENERAL if the codes differ
+func (ae AggregateError) ErrorCode() of.ErrorCode {
+	var code of.ErrorCode
+	for _, err := range ae {
+		switch errCode := err.ErrorCode(); {
+		case code == "":
+			code = errCode
+		case code != errCode:
+			return of.GeneralCode
 		}
-		return strings.Join(errs, ", ")
 	}
+	return cmp.Or(code, of.GeneralCode)
+}
+
+func (ae AggregateError) Error() string {
+	errs := make([]string, 0, len(ae))
+	for _, err := range ae.Errors() {
+		errs = append(errs, err.Error())
+	}
+	return strings.Join(errs, ", ")
+}
 
-	return "" // This will never occur, switch is exhaustive
+// ErrorMessage Returns the errors of all providers like Error, but without the codes of the resolution errors
+func (ae AggregateError) ErrorMessage() string {
+	errs := make([]string, 0, len(ae))
+	for _, err := range ae.Errors() {
+		errs = append(errs, fmt.Sprintf("Provider %s: %s", err.ProviderName, err.ErrorMessage()))
+	}
+	return strings.Join(errs, ", ")
 }
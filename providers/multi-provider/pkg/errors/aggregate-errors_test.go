@@ -0,0 +1,90 @@
+package errors
+
+import (
+	"errors"
+	"testing"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateError_Error(t *testing.T) {
+	t.Run("no errors", func(t *testing.T) {
+		assert.Empty(t, NewAggregateError(nil).Error())
+	})
+
+	t.Run("single error", func(t *testing.T) {
+		err := NewAggregateError([]ProviderError{{ProviderName: "flagd", Err: errors.New("test error")}})
+		assert.Equal(t, "Provider flagd: test error", err.Error())
+	})
+
+	t.Run("multiple errors retain order", func(t *testing.T) {
+		err := NewAggregateError([]ProviderError{
+			{ProviderName: "local", Err: errors.New("error 1")},
+			{ProviderName: "flagd", Err: errors.New("error 2")},
+			{ProviderName: "vendor", Err: errors.New("error 3")},
+		})
+		for range 10 {
+			assert.Equal(t, "Provider local: error 1, Provider flagd: error 2, Provider vendor: error 3", err.Error())
+		}
+	})
+}
+
+func TestAggregateError_Unwrap(t *testing.T) {
+	errTimeout := errors.New("timeout")
+	err := error(NewAggregateError([]ProviderError{
+		{ProviderName: "local", Err: of.NewFlagNotFoundResolutionError("flag not found")},
+		{ProviderName: "flagd", Err: errTimeout},
+	}))
+
+	assert.ErrorIs(t, err, errTimeout)
+
+	var providerErr *ProviderError
+	require.ErrorAs(t, err, &providerErr)
+	assert.Equal(t, "local", providerErr.ProviderName)
+
+	var rErr of.ResolutionError
+	require.ErrorAs(t, err, &rErr)
+	assert.Equal(t, of.NewFlagNotFoundResolutionError("flag not found"), rErr)
+
+	unwrapped := NewAggregateError([]ProviderError{{ProviderName: "b"}, {ProviderName: "a"}}).Unwrap()
+	require.Len(t, unwrapped, 2)
+	assert.Equal(t, "b", unwrapped[0].(*ProviderError).ProviderName)
+	assert.Equal(t, "a", unwrapped[1].(*ProviderError).ProviderName)
+}
+
+func TestAggregateError_ErrorCode(t *testing.T) {
+	t.Run("shared code", func(t *testing.T) {
+		err := NewAggregateError([]ProviderError{
+			{ProviderName: "local", Err: of.NewFlagNotFoundResolutionError("flag not found")},
+			{ProviderName: "flagd", Err: of.NewFlagNotFoundResolutionError("unknown flag")},
+		})
+		assert.Equal(t, of.FlagNotFoundCode, err.ErrorCode())
+	})
+
+	t.Run("differing codes", func(t *testing.T) {
+		err := NewAggregateError([]ProviderError{
+			{ProviderName: "local", Err: of.NewFlagNotFoundResolutionError("flag not found")},
+			{ProviderName: "flagd", Err: of.NewProviderNotReadyResolutionError("connecting")},
+		})
+		assert.Equal(t, of.GeneralCode, err.ErrorCode())
+		assert.Equal(t, "Provider local: flag not found, Provider flagd: connecting", err.ErrorMessage())
+	})
+
+	t.Run("plain errors are general errors", func(t *testing.T) {
+		err := NewAggregateError([]ProviderError{{ProviderName: "flagd", Err: errors.New("timeout")}})
+		assert.Equal(t, of.GeneralCode, err.ErrorCode())
+		assert.Equal(t, of.GeneralCode, NewAggregateError(nil).ErrorCode())
+	})
+}
+
+func TestProviderError_ErrorMessage(t *testing.T) {
+	err := ProviderError{ProviderName: "flagd", Err: of.NewProviderNotReadyResolutionError("connecting")}
+	assert.Equal(t, of.ProviderNotReadyCode, err.ErrorCode())
+	assert.Equal(t, "connecting", err.ErrorMessage())
+	assert.Equal(t, "Provider flagd: PROVIDER_NOT_READY: connecting", err.Error())
+
+	err = ProviderError{ProviderName: "flagd", Err: errors.New("timeout")}
+	assert.Equal(t, "timeout", err.ErrorMessage())
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg/strategies"
 	of "github.com/open-feature/go-sdk/openfeature"
 	"log/slog"
+	"maps"
 	"time"
 )
 
@@ -21,6 +22,52 @@ func WithTimeout(d time.Duration) Option {
 	}
 }
 
+// WithHedgeDelay Enables hedged requests for StrategyFirstSuccess. Providers are started in order, and the next provider
+// is only started if the providers started so far have not succeeded within the delay. The timeout set via WithTimeout
+// remains the limit for the whole evaluation.
+func WithHedgeDelay(d time.Duration) Option {
+	return func(conf *Configuration) {
+		conf.hedgeDelay = d
+	}
+}
+
+// WithReadinessPolicy Sets when the MultiProvider counts as ready, e.g. ReadyWhenAny or ReadyWhenPrimary. Init returns
+// as soon as the policy is satisfied, and providers failing to initialize no longer fail the MultiProvider as long as
+// the policy remains satisfied. Failed providers are retried in the background and rejoin once they recover, see
+// WithInitRetryInterval. By default all providers must be ready and failed providers are not retried.
+func WithReadinessPolicy(p ReadinessPolicy) Option {
+	return func(conf *Configuration) {
+		conf.readinessPolicy = &p
+	}
+}
+
+// WithProviderInitTimeouts Sets the time individual providers have to initialize, keyed by provider name, overriding
+// the timeout set via WithInitTimeout for them. A timeout of 0 disables the timeout for that provider.
+func WithProviderInitTimeouts(timeouts map[string]time.Duration) Option {
+	timeouts = maps.Clone(timeouts)
+	return func(conf *Configuration) {
+		conf.providerInitTimeouts = timeouts
+	}
+}
+
+// WithInitTimeout Sets the time each provider has to initialize. Providers exceeding it are considered failed, although
+// their initialization continues in the background and they rejoin once it succeeds. By default there is no timeout.
+// The timeout of individual providers can be set via WithProviderInitTimeouts.
+func WithInitTimeout(d time.Duration) Option {
+	return func(conf *Configuration) {
+		conf.initTimeout = d
+	}
+}
+
+// WithInitRetryInterval Sets the delay before a provider that failed to initialize is retried. The delay doubles after
+// every failed attempt up to one minute. Retries are enabled with a delay of 5 seconds if a readiness policy is set, a
+// negative delay disables them.
+func WithInitRetryInterval(d time.Duration) Option {
+	return func(conf *Configuration) {
+		conf.initRetryInterval = d
+	}
+}
+
 // WithFallbackProvider Sets a fallback provider when using the StrategyComparison
 func WithFallbackProvider(p of.FeatureProvider) Option {
 	return func(conf *Configuration) {
@@ -29,6 +76,78 @@ func WithFallbackProvider(p of.FeatureProvider) Option {
 	}
 }
 
+// WithObjectComparator Sets the function used by StrategyComparison for comparing object flag values. By default
+// strategies.JSONEqual is used.
+func WithObjectComparator(c strategies.ObjectComparator) Option {
+	return func(conf *Configuration) {
+		conf.objectComparator = c
+	}
+}
+
+// WithCircuitBreaker Adds a circuit breaker using the given configuration to every provider that does not have one set
+// on its NamedProvider. Circuit breakers are used by StrategyFirstMatch & StrategyFirstSuccess, which skip providers
+// whose circuit is open.
+func WithCircuitBreaker(config strategies.CircuitBreakerConfig) Option {
+	return func(conf *Configuration) {
+		conf.circuitBreaker = &config
+	}
+}
+
+// WithShadowMismatchHandler Sets the handler called in the background whenever a shadow provider disagrees with the
+// primary provider when using StrategyShadow
+func WithShadowMismatchHandler(h strategies.ShadowMismatchHandler) Option {
+	return func(conf *Configuration) {
+		conf.shadowMismatchHandler = h
+	}
+}
+
+// WithMaxInFlightShadows Limits the shadow evaluations running in the background at the same time when using
+// StrategyShadow. Shadow evaluations exceeding the limit are dropped and logged. Defaults to
+// strategies.DefaultMaxInFlightShadows.
+func WithMaxInFlightShadows(limit int) Option {
+	return func(conf *Configuration) {
+		conf.maxInFlightShadows = limit
+	}
+}
+
+// WithFlagKeyRoutes Sets the routes used by StrategyFlagKeyRouting. Routes are matched in order, flags not matching any
+// route are sent to the default provider. If the default provider is empty such flags resolve to FLAG_NOT_FOUND.
+func WithFlagKeyRoutes(defaultProvider string, routes ...strategies.FlagKeyRoute) Option {
+	return func(conf *Configuration) {
+		conf.defaultRouteProvider = defaultProvider
+		conf.flagKeyRoutes = append(conf.flagKeyRoutes, routes...)
+	}
+}
+
+// WithContextRoutes Sets the routes used by StrategyContextRouting. Routes map values of the evaluation context
+// attribute to provider names. Evaluations with a missing, unmapped or non-scalar attribute value are sent to the default
+// provider.
+// If the default provider is empty such evaluations resolve to INVALID_CONTEXT, or TARGETING_KEY_MISSING if the
+// attribute is the targeting key.
+func WithContextRoutes(attribute string, defaultProvider string, routes map[string]string) Option {
+	return func(conf *Configuration) {
+		conf.routingAttribute = attribute
+		conf.defaultRouteProvider = defaultProvider
+		conf.contextRoutes = routes
+	}
+}
+
+// WithQuorum Sets the combined weight of the providers that must agree on a value for it to win when using
+// StrategyVoting. By default a strict majority of the combined weight of all providers is required.
+func WithQuorum(quorum int) Option {
+	return func(conf *Configuration) {
+		conf.quorum = quorum
+	}
+}
+
+// WithProviderWeights Sets the weight of each provider's vote when using StrategyVoting. Providers without a weight
+// have a weight of 1.
+func WithProviderWeights(weights map[string]int) Option {
+	return func(conf *Configuration) {
+		conf.providerWeights = weights
+	}
+}
+
 // WithCustomStrategy sets a custom strategy. This must be used in conjunction with StrategyCustom
 func WithCustomStrategy(s strategies.Strategy) Option {
 	return func(conf *Configuration) {
@@ -36,7 +155,35 @@ func WithCustomStrategy(s strategies.Strategy) Option {
 	}
 }
 
-// WithEventPublishing Enables event publishing (Not Yet Implemented)
+// WithHooks Sets hooks which apply to the whole MultiProvider. These are executed by the OpenFeature SDK around the
+// evaluation of the MultiProvider, while the hooks of each internal provider are executed around that provider's own
+// evaluation.
+func WithHooks(hooks ...of.Hook) Option {
+	return func(conf *Configuration) {
+		conf.hooks = append(conf.hooks, hooks...)
+	}
+}
+
+// WithObserver Sets an observer receiving the provider name, flag key, duration, error code and whether the result was
+// selected for every evaluation of an internal provider. This is not supported for custom strategies, unless their
+// providers are wrapped using strategies.NewObservedProviders.
+func WithObserver(o strategies.Observer) Option {
+	return func(conf *Configuration) {
+		conf.observer = o
+	}
+}
+
+// WithTrackingProviders Limits the internal providers tracking events are forwarded to. By default tracking events are
+// forwarded to every internal provider supporting tracking.
+func WithTrackingProviders(names ...string) Option {
+	return func(conf *Configuration) {
+		conf.trackingProviders = append(make([]string, 0, len(names)), names...)
+	}
+}
+
+// WithEventPublishing Enables publishing of the configuration change events emitted by internal providers via
+// EventChannel, and delivers every event without dropping it, so the channel must be consumed. Events are tagged with
+// the name of the internal provider they originated from. Changes of the overall state are always published.
 func WithEventPublishing() Option {
 	return func(conf *Configuration) {
 		conf.publishEvents = true
@@ -8,12 +8,10 @@ import (
 	"maps"
 	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg/strategies"
-	"golang.org/x/sync/errgroup"
-
-	mperr "github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg/errors"
 
 	of "github.com/open-feature/go-sdk/openfeature"
 )
@@ -21,25 +19,73 @@ import (
 type (
 	// MultiProvider Provider used for combining multiple providers
 	MultiProvider struct {
-		providers ProviderMap
-		metadata  of.Metadata
-		events    chan of.Event
-		status    of.State
-		mu        sync.RWMutex
-		strategy  strategies.Strategy
-		logger    *slog.Logger
+		// current holds the internal providers along with the strategy built for them. It is replaced as a whole when
+		// providers are added or removed, so evaluations always see a consistent set of providers.
+		current            atomic.Pointer[providerSet]
+		config             *Configuration
+		evaluationStrategy EvaluationStrategy
+		// changeMu serializes adding & removing providers
+		changeMu       sync.Mutex
+		events         chan of.Event
+		status         of.State
+		providerStatus map[string]of.State
+		circuitStates  map[string]strategies.CircuitState
+		trackingNames  []string
+		initCtx        of.EvaluationContext
+		initRunCtx     context.Context
+		initCancel     context.CancelFunc
+		initCancels    map[string]context.CancelFunc
+		initGroup      sync.WaitGroup
+		mu             sync.RWMutex
+		logger         *slog.Logger
+		hooks          []of.Hook
+		listenerCtx    context.Context
+		shutdownFunc   context.CancelFunc
+		listeners      map[string]*eventListener
+		workerGroup    sync.WaitGroup
+	}
+
+	// providerSet Immutable snapshot of the internal providers and the strategy evaluating them
+	providerSet struct {
+		providers    ProviderMap
+		providerList []*strategies.NamedProvider
+		metadata     of.Metadata
+		strategy     strategies.Strategy
+	}
+
+	// eventListener Worker forwarding the events of a single internal provider
+	eventListener struct {
+		cancel context.CancelFunc
+		done   chan struct{}
 	}
 
 	// Configuration MultiProvider's internal configuration
 	Configuration struct {
-		useFallback      bool
-		fallbackProvider of.FeatureProvider
-		customStrategy   strategies.Strategy
-		logger           *slog.Logger
-		publishEvents    bool
-		metadata         *of.Metadata //nolint unused
-		timeout          time.Duration
-		hooks            []of.Hook //nolint unused - Not implemented yet
+		useFallback           bool
+		fallbackProvider      of.FeatureProvider
+		customStrategy        strategies.Strategy
+		logger                *slog.Logger
+		publishEvents         bool
+		metadata              *of.Metadata //nolint unused
+		timeout               time.Duration
+		hooks                 []of.Hook
+		shadowMismatchHandler strategies.ShadowMismatchHandler
+		maxInFlightShadows    int
+		flagKeyRoutes         []strategies.FlagKeyRoute
+		defaultRouteProvider  string
+		routingAttribute      string
+		contextRoutes         map[string]string
+		quorum                int
+		providerWeights       map[string]int
+		objectComparator      strategies.ObjectComparator
+		circuitBreaker        *strategies.CircuitBreakerConfig
+		hedgeDelay            time.Duration
+		trackingProviders     []string
+		readinessPolicy       *ReadinessPolicy
+		initTimeout           time.Duration
+		providerInitTimeouts  map[string]time.Duration
+		initRetryInterval     time.Duration
+		observer              strategies.Observer
 	}
 
 	// EvaluationStrategy Defines a strategy to use for resolving the result from multiple providers
@@ -52,43 +98,91 @@ type (
 
 const (
 	// StrategyFirstMatch First provider whose response that is not FlagNotFound will be returned. This is executed
-	// sequentially, and not in parallel.
+	// sequentially in the order the providers were registered, and not in parallel.
 	StrategyFirstMatch EvaluationStrategy = strategies.StrategyFirstMatch
 	// StrategyFirstSuccess First provider response that is not an error will be returned. This is executed in parallel
 	StrategyFirstSuccess EvaluationStrategy = strategies.StrategyFirstSuccess
 	// StrategyComparison All providers are called in parallel. If all responses agree the value will be returned.
-	// Otherwise, the value from the designated fallback provider's response will be returned. The fallback provider
-	// will be assigned to the first provider registered.
+	// Otherwise, the value from the designated fallback provider's response will be returned. If no fallback provider
+	// is set via WithFallbackProvider, the first provider registered is used as the fallback.
 	StrategyComparison EvaluationStrategy = "comparison"
+	// StrategyShadow The result of the first provider registered is always returned. All other providers are evaluated
+	// in the background as shadows without blocking the caller. Mismatches are reported to the handler set via
+	// WithShadowMismatchHandler, or logged if none is set.
+	StrategyShadow EvaluationStrategy = strategies.StrategyShadow
+	// StrategyFlagKeyRouting Each flag is evaluated by exactly one provider, selected by matching the flag key against
+	// the routes set via WithFlagKeyRoutes. Flags not matching any route are sent to the default provider.
+	StrategyFlagKeyRouting EvaluationStrategy = strategies.StrategyFlagKeyRouting
+	// StrategyContextRouting Each flag is evaluated by exactly one provider, selected by the value of an evaluation
+	// context attribute as configured via WithContextRoutes.
+	StrategyContextRouting EvaluationStrategy = strategies.StrategyContextRouting
+	// StrategyVoting All providers are called in parallel and vote for the value they resolved. The value reaching the
+	// quorum set via WithQuorum wins, by default a strict majority of all providers is required. Providers can be
+	// weighted via WithProviderWeights.
+	StrategyVoting EvaluationStrategy = strategies.StrategyVoting
 	// StrategyCustom allows for using a custom Strategy implementation. If this is set you MUST use the WithCustomStrategy
 	// option to set it
 	StrategyCustom EvaluationStrategy = "strategy-custom"
+
+	// MetadataProviderName Key of the event metadata entry holding the name of the internal provider an event originated from
+	MetadataProviderName = "multiprovider-provider-name"
+	// MetadataProviderType Key of the event metadata entry holding the metadata name of the internal provider an event originated from
+	MetadataProviderType = "multiprovider-provider-type"
+	// MetadataCircuitState Key of the event metadata entry holding the new state of the circuit breaker of an internal provider
+	MetadataCircuitState = "multiprovider-circuit-state"
 )
 
-var _ of.FeatureProvider = (*MultiProvider)(nil)
+var (
+	// stateValues Weights used to determine the overall state of the MultiProvider. The highest weight wins.
+	stateValues = map[of.State]int{
+		of.ReadyState:    0,
+		of.StaleState:    1,
+		of.NotReadyState: 2,
+		of.ErrorState:    3,
+		of.FatalState:    3,
+	}
+	// eventTypeToState Maps the state events emitted by internal providers to the state they represent
+	eventTypeToState = map[of.EventType]of.State{
+		of.ProviderReady: of.ReadyState,
+		of.ProviderStale: of.StaleState,
+		of.ProviderError: of.ErrorState,
+	}
+	// stateToEventType Maps the overall state of the MultiProvider to the event announcing it
+	stateToEventType = map[of.State]of.EventType{
+		of.ReadyState: of.ProviderReady,
+		of.StaleState: of.ProviderStale,
+		of.ErrorState: of.ProviderError,
+	}
+)
+
+var (
+	_ of.FeatureProvider = (*MultiProvider)(nil)
+	_ of.StateHandler    = (*MultiProvider)(nil)
+	_ of.EventHandler    = (*MultiProvider)(nil)
+)
 
-// AsNamedProviderSlice Converts the map into a slice of NamedProvider instances
+// AsNamedProviderSlice Converts the map into a slice of NamedProvider instances sorted by name. Use
+// NewOrderedMultiProvider if a specific order is required.
 func (m ProviderMap) AsNamedProviderSlice() []*strategies.NamedProvider {
 	s := make([]*strategies.NamedProvider, 0, len(m))
-	for name, provider := range m {
-		s = append(s, &strategies.NamedProvider{Name: name, Provider: provider})
+	for _, name := range slices.Sorted(maps.Keys(m)) {
+		s = append(s, &strategies.NamedProvider{Name: name, Provider: m[name]})
 	}
 
 	return s
 }
 
-// Size The size of the map. This operates in O(n) time.
+// Size The size of the map.
 func (m ProviderMap) Size() int {
-	return len(m.AsNamedProviderSlice())
+	return len(m)
 }
 
-func (m ProviderMap) buildMetadata() of.Metadata {
+// buildMetadata builds the metadata name out of the names of the providers, retaining the order of the providers
+func buildMetadata(providers []*strategies.NamedProvider) of.Metadata {
 	var separator string
 	metaName := "MultiProvider {"
-	names := slices.Collect(maps.Keys(m))
-	slices.Sort(names)
-	for _, name := range names {
-		metaName = fmt.Sprintf("%s%s%s: %s", metaName, separator, name, m[name].Metadata().Name)
+	for _, p := range providers {
+		metaName = fmt.Sprintf("%s%s%s: %s", metaName, separator, p.Name, p.Provider.Metadata().Name)
 		if separator == "" {
 			separator = ", "
 		}
@@ -99,22 +193,47 @@ func (m ProviderMap) buildMetadata() of.Metadata {
 	}
 }
 
-// NewMultiProvider returns the unified interface of multiple providers for interaction.
+// NewMultiProvider returns the unified interface of multiple providers for interaction. As maps are unordered the
+// providers are ordered by name. Use NewOrderedMultiProvider to control the order of the providers.
 //
 // Deprecated: Use multi.NewProvider() from github.com/open-feature/go-sdk/openfeature/multi instead.
 func NewMultiProvider(providerMap ProviderMap, evaluationStrategy EvaluationStrategy, options ...Option) (*MultiProvider, error) {
 	if len(providerMap) == 0 {
 		return nil, errors.New("providerMap cannot be nil or empty")
 	}
+
+	return NewOrderedMultiProvider(providerMap.AsNamedProviderSlice(), evaluationStrategy, options...)
+}
+
+// NewOrderedMultiProvider returns the unified interface of multiple providers for interaction. The order of the
+// providers is retained by all strategies, in the metadata and in error reports.
+//
+// Deprecated: Use multi.NewProvider() from github.com/open-feature/go-sdk/openfeature/multi instead.
+func NewOrderedMultiProvider(providers []*strategies.NamedProvider, evaluationStrategy EvaluationStrategy, options ...Option) (*MultiProvider, error) {
+	if len(providers) == 0 {
+		return nil, errors.New("providers cannot be nil or empty")
+	}
 	// Validate Providers
-	for name, provider := range providerMap {
-		if name == "" {
+	providerMap := make(ProviderMap, len(providers))
+	providerList := make([]*strategies.NamedProvider, 0, len(providers))
+	for _, p := range providers {
+		if p == nil {
+			return nil, errors.New("named provider cannot be nil")
+		}
+
+		if p.Name == "" {
 			return nil, errors.New("provider name cannot be the empty string")
 		}
 
-		if provider == nil {
-			return nil, fmt.Errorf("provider %s cannot be nil", name)
+		if p.Provider == nil {
+			return nil, fmt.Errorf("provider %s cannot be nil", p.Name)
 		}
+
+		if _, exists := providerMap[p.Name]; exists {
+			return nil, fmt.Errorf("provider name %s is used more than once", p.Name)
+		}
+		providerMap[p.Name] = p.Provider
+		providerList = append(providerList, &strategies.NamedProvider{Name: p.Name, Provider: p.Provider, CircuitBreaker: p.CircuitBreaker})
 	}
 
 	config := &Configuration{
@@ -125,9 +244,42 @@ func NewMultiProvider(providerMap ProviderMap, evaluationStrategy EvaluationStra
 		opt(config)
 	}
 
-	var eventChannel chan of.Event
-	if config.publishEvents {
-		eventChannel = make(chan of.Event)
+	if config.circuitBreaker != nil {
+		for _, p := range providerList {
+			if p.CircuitBreaker != nil {
+				continue
+			}
+			cb, err := strategies.NewCircuitBreaker(*config.circuitBreaker)
+			if err != nil {
+				return nil, err
+			}
+			p.CircuitBreaker = cb
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(config.providerInitTimeouts)) {
+		if _, exists := providerMap[name]; !exists {
+			return nil, fmt.Errorf("init timeout provider %s is not a known provider", name)
+		}
+		if config.providerInitTimeouts[name] < 0 {
+			return nil, fmt.Errorf("init timeout of provider %s cannot be negative", name)
+		}
+	}
+
+	for _, name := range config.trackingProviders {
+		provider, exists := providerMap[name]
+		if !exists {
+			return nil, fmt.Errorf("tracking provider %s is not a known provider", name)
+		}
+		if !supportsTracking(provider) {
+			return nil, fmt.Errorf("tracking provider %s does not support tracking", name)
+		}
+	}
+
+	if config.readinessPolicy != nil {
+		if err := config.readinessPolicy.validate(providerMap); err != nil {
+			return nil, err
+		}
 	}
 
 	logger := config.logger
@@ -135,26 +287,92 @@ func NewMultiProvider(providerMap ProviderMap, evaluationStrategy EvaluationStra
 		logger = slog.Default()
 	}
 
-	multiProvider := &MultiProvider{
-		providers: providerMap,
-		events:    eventChannel,
-		logger:    logger,
-		metadata:  providerMap.buildMetadata(),
-	}
-
 	var zeroDuration time.Duration
 	if config.timeout == zeroDuration {
 		config.timeout = 5 * time.Second
 	}
 
+	set, err := newProviderSet(evaluationStrategy, providerList, config)
+	if err != nil {
+		return nil, err
+	}
+
+	providerStatus := make(map[string]of.State, len(providerList))
+	circuitStates := make(map[string]strategies.CircuitState, len(providerList))
+	for _, p := range providerList {
+		providerStatus[p.Name] = of.NotReadyState
+		circuitStates[p.Name] = p.CircuitBreaker.State()
+	}
+
+	multiProvider := &MultiProvider{
+		config:             config,
+		evaluationStrategy: evaluationStrategy,
+		events:             make(chan of.Event, len(providerList)),
+		logger:             logger,
+		status:             of.NotReadyState,
+		providerStatus:     providerStatus,
+		circuitStates:      circuitStates,
+		trackingNames:      config.trackingProviders,
+		hooks:              config.hooks,
+		listeners:          make(map[string]*eventListener),
+		initCancels:        make(map[string]context.CancelFunc),
+	}
+	multiProvider.current.Store(set)
+
+	for _, p := range providerList {
+		multiProvider.watchCircuitBreaker(p)
+	}
+
+	return multiProvider, nil
+}
+
+// newProviderSet builds the strategy for the given providers. The hooks of each provider are executed around the
+// evaluation of that provider, and the outcome of each evaluation is reported to the observer, if one is set.
+func newProviderSet(evaluationStrategy EvaluationStrategy, providerList []*strategies.NamedProvider, config *Configuration) (*providerSet, error) {
+	providerMap := make(ProviderMap, len(providerList))
+	for _, p := range providerList {
+		providerMap[p.Name] = p.Provider
+	}
+
+	hookedProviders := strategies.NewHookedProviders(providerList)
+	fallbackProvider := config.fallbackProvider
+	if fallbackProvider == nil {
+		fallbackProvider = providerList[0].Provider
+	}
+	fallbackProvider = strategies.NewHookedProvider(fallbackProvider)
+	if config.observer != nil {
+		hookedProviders = strategies.NewObservedProviders(hookedProviders)
+		fallbackProvider = strategies.NewObservedProvider("fallback", fallbackProvider)
+	}
 	var strategy strategies.Strategy
 	switch evaluationStrategy {
 	case StrategyFirstMatch:
-		strategy = strategies.NewFirstMatchStrategy(multiProvider.Providers())
+		strategy = strategies.NewFirstMatchStrategy(hookedProviders)
 	case StrategyFirstSuccess:
-		strategy = strategies.NewFirstSuccessStrategy(multiProvider.Providers(), config.timeout)
+		strategy = strategies.NewFirstSuccessStrategy(hookedProviders, config.timeout, strategies.WithHedgeDelay(config.hedgeDelay))
 	case StrategyComparison:
-		strategy = strategies.NewComparisonStrategy(multiProvider.Providers(), config.fallbackProvider)
+		strategy = strategies.NewComparisonStrategy(hookedProviders, fallbackProvider, strategies.WithObjectComparator(config.objectComparator))
+	case StrategyShadow:
+		strategy = strategies.NewShadowStrategy(hookedProviders[0], hookedProviders[1:], config.shadowMismatchHandler, config.timeout,
+			strategies.WithMaxInFlightShadows(config.maxInFlightShadows))
+	case StrategyFlagKeyRouting:
+		var err error
+		strategy, err = strategies.NewFlagKeyRoutingStrategy(hookedProviders, config.flagKeyRoutes, config.defaultRouteProvider)
+		if err != nil {
+			return nil, err
+		}
+	case StrategyContextRouting:
+		var err error
+		strategy, err = strategies.NewContextRoutingStrategy(hookedProviders, config.routingAttribute, config.contextRoutes, config.defaultRouteProvider)
+		if err != nil {
+			return nil, err
+		}
+	case StrategyVoting:
+		var err error
+		strategy, err = strategies.NewVotingStrategy(hookedProviders, config.quorum, config.providerWeights, config.timeout)
+		if err != nil {
+			return nil, err
+		}
 	case StrategyCustom:
 		if config.customStrategy != nil {
 			strategy = config.customStrategy
@@ -162,99 +380,508 @@ func NewMultiProvider(providerMap ProviderMap, evaluationStrategy EvaluationStra
 			return nil, fmt.Errorf("A custom strategy must be set via an option if StrategyCustom is set")
 		}
 	default:
-		return nil, fmt.Errorf("%s is an unknown evalutation strategy", strategy)
+		return nil, fmt.Errorf("%s is an unknown evalutation strategy", evaluationStrategy)
 	}
-	multiProvider.strategy = strategy
 
-	return multiProvider, nil
+	return &providerSet{
+		providers:    providerMap,
+		providerList: providerList,
+		metadata:     buildMetadata(providerList),
+		strategy:     strategies.NewObservedStrategy(strategy, config.observer),
+	}, nil
+}
+
+// watchCircuitBreaker reflects the state changes of the circuit breaker of the provider in the overall state
+func (mp *MultiProvider) watchCircuitBreaker(p *strategies.NamedProvider) {
+	if p.CircuitBreaker == nil {
+		return
+	}
+	name, provider := p.Name, p.Provider
+	p.CircuitBreaker.OnStateChange(func(from strategies.CircuitState, to strategies.CircuitState) {
+		mp.updateCircuitState(name, provider, from, to)
+	})
 }
 
-// Providers Returns slice of providers wrapped in NamedProvider structs
+// Providers Returns slice of providers wrapped in NamedProvider structs in the order they were registered
 func (mp *MultiProvider) Providers() []*strategies.NamedProvider {
-	return mp.providers.AsNamedProviderSlice()
+	return slices.Clone(mp.current.Load().providerList)
 }
 
-// ProvidersByName Returns the internal ProviderMap of the MultiProvider
+// ProvidersByName Returns a copy of the internal ProviderMap of the MultiProvider
 func (mp *MultiProvider) ProvidersByName() ProviderMap {
-	return mp.providers
+	return maps.Clone(mp.current.Load().providers)
 }
 
 // EvaluationStrategy The current set strategy
 func (mp *MultiProvider) EvaluationStrategy() string {
-	return mp.strategy.Name()
+	return mp.current.Load().strategy.Name()
 }
 
 // Metadata provides the name `multiprovider` and the names of each provider passed.
 func (mp *MultiProvider) Metadata() of.Metadata {
-	return mp.metadata
+	return mp.current.Load().metadata
 }
 
-// Hooks returns a collection of.Hook defined by this provider
+// Hooks returns the hooks set via WithHooks, which apply to the whole MultiProvider. The hooks of the internal providers
+// are executed by the strategies around the evaluation of each provider.
 func (mp *MultiProvider) Hooks() []of.Hook {
-	// Hooks that should be included with the provider
-	return []of.Hook{}
+	return slices.Clone(mp.hooks)
 }
 
 // BooleanEvaluation returns a boolean flag
 func (mp *MultiProvider) BooleanEvaluation(ctx context.Context, flag string, defaultValue bool, evalCtx of.FlattenedContext) of.BoolResolutionDetail {
-	return mp.strategy.BooleanEvaluation(ctx, flag, defaultValue, evalCtx)
+	return mp.current.Load().strategy.BooleanEvaluation(ctx, flag, defaultValue, evalCtx)
 }
 
 // StringEvaluation returns a string flag
 func (mp *MultiProvider) StringEvaluation(ctx context.Context, flag string, defaultValue string, evalCtx of.FlattenedContext) of.StringResolutionDetail {
-	return mp.strategy.StringEvaluation(ctx, flag, defaultValue, evalCtx)
+	return mp.current.Load().strategy.StringEvaluation(ctx, flag, defaultValue, evalCtx)
 }
 
 // FloatEvaluation returns a float flag
 func (mp *MultiProvider) FloatEvaluation(ctx context.Context, flag string, defaultValue float64, evalCtx of.FlattenedContext) of.FloatResolutionDetail {
-	return mp.strategy.FloatEvaluation(ctx, flag, defaultValue, evalCtx)
+	return mp.current.Load().strategy.FloatEvaluation(ctx, flag, defaultValue, evalCtx)
 }
 
 // IntEvaluation returns an int flag
 func (mp *MultiProvider) IntEvaluation(ctx context.Context, flag string, defaultValue int64, evalCtx of.FlattenedContext) of.IntResolutionDetail {
-	return mp.strategy.IntEvaluation(ctx, flag, defaultValue, evalCtx)
+	return mp.current.Load().strategy.IntEvaluation(ctx, flag, defaultValue, evalCtx)
 }
 
 // ObjectEvaluation returns an object flag
 func (mp *MultiProvider) ObjectEvaluation(ctx context.Context, flag string, defaultValue interface{}, evalCtx of.FlattenedContext) of.InterfaceResolutionDetail {
-	return mp.strategy.ObjectEvaluation(ctx, flag, defaultValue, evalCtx)
+	return mp.current.Load().strategy.ObjectEvaluation(ctx, flag, defaultValue, evalCtx)
 }
 
-// Init will run the initialize method for all of provides and aggregate the errors.
+// Init will run the initialize method for all of provides and aggregate the errors. It returns as soon as the readiness
+// policy set via WithReadinessPolicy is satisfied, by default once all providers are ready, or once every provider
+// finished its first attempt. Once initialization has finished the MultiProvider starts listening to the events of every
+// internal provider implementing of.EventHandler, regardless of the initialization result, so that providers recovering
+// later on are reflected in the overall state.
 func (mp *MultiProvider) Init(evalCtx of.EvaluationContext) error {
-	var eg errgroup.Group
+	err := mp.initializeProviders(evalCtx)
+	mp.startEventListeners()
+	return err
+}
 
-	for name, provider := range mp.providers {
-		eg.Go(func() error {
-			stateHandle, ok := provider.(of.StateHandler)
-			if !ok {
-				return nil
-			}
-			if err := stateHandle.Init(evalCtx); err != nil {
-				return &mperr.ProviderError{
-					Err:          err,
-					ProviderName: name,
-				}
-			}
+// AddProvider Initializes the provider and adds it as the last internal provider. The strategy and the metadata are
+// rebuilt and replaced atomically, so evaluations running concurrently use either the previous or the new set of
+// providers. The provider is initialized like the providers passed to the constructor, honoring the init timeout. If
+// its first attempt fails or times out, it is only added if a readiness policy is set and remains satisfied, in which
+// case it is retried in the background like during Init. Otherwise it is not added and is shut down.
+func (mp *MultiProvider) AddProvider(name string, provider of.FeatureProvider) error {
+	if name == "" {
+		return errors.New("provider name cannot be the empty string")
+	}
+	if provider == nil {
+		return fmt.Errorf("provider %s cannot be nil", name)
+	}
+
+	mp.changeMu.Lock()
+	defer mp.changeMu.Unlock()
+
+	current := mp.current.Load()
+	if _, exists := current.providers[name]; exists {
+		return fmt.Errorf("provider name %s is used more than once", name)
+	}
+	if mp.evaluationStrategy == StrategyCustom {
+		return errors.New("providers cannot be added when using a custom strategy")
+	}
 
-			return nil
-		})
+	namedProvider := &strategies.NamedProvider{Name: name, Provider: provider}
+	if mp.config.circuitBreaker != nil {
+		cb, err := strategies.NewCircuitBreaker(*mp.config.circuitBreaker)
+		if err != nil {
+			return err
+		}
+		namedProvider.CircuitBreaker = cb
 	}
 
-	if err := eg.Wait(); err != nil {
+	set, err := newProviderSet(mp.evaluationStrategy, append(slices.Clone(current.providerList), namedProvider), mp.config)
+	if err != nil {
+		return err
+	}
+
+	// the provider's state is tracked during its initialization, so a recovery in the background is not missed
+	mp.mu.Lock()
+	previous := mp.status
+	mp.providerStatus[name] = of.NotReadyState
+	mp.circuitStates[name] = namedProvider.CircuitBreaker.State()
+	mp.mu.Unlock()
+
+	if err := mp.initializeAddedProvider(namedProvider); err != nil {
 		mp.mu.Lock()
-		defer mp.mu.Unlock()
-		mp.status = of.ErrorState
+		delete(mp.providerStatus, name)
+		delete(mp.circuitStates, name)
+		mp.status = mp.evaluateState()
+		mp.mu.Unlock()
+		if stateHandle, ok := provider.(of.StateHandler); ok {
+			// the initialization might still be running, so the caller must not wait for the shutdown
+			go stateHandle.Shutdown()
+		}
+		return err
+	}
+
+	mp.mu.Lock()
+	mp.current.Store(set)
+	mp.status = mp.evaluateState()
+	overall := mp.status
+	mp.startEventListener(namedProvider)
+	mp.mu.Unlock()
+	mp.watchCircuitBreaker(namedProvider)
+
+	mp.logger.LogAttrs(context.Background(), slog.LevelInfo, "provider added", slog.String(MetadataProviderName, name))
+	mp.publishProviderChange(name, provider, fmt.Sprintf("provider %s added", name), previous, overall)
+	return nil
+}
+
+// RemoveProvider Removes the internal provider and shuts it down. The strategy and the metadata are rebuilt and
+// replaced atomically, evaluations already using the provider are not interrupted. The last remaining provider and the
+// primary provider of StrategyShadow cannot be removed.
+func (mp *MultiProvider) RemoveProvider(name string) error {
+	mp.changeMu.Lock()
+	defer mp.changeMu.Unlock()
+
+	current := mp.current.Load()
+	provider, exists := current.providers[name]
+	if !exists {
+		return fmt.Errorf("provider %s is not a known provider", name)
+	}
+	if len(current.providerList) == 1 {
+		return errors.New("the last provider cannot be removed")
+	}
+	if mp.evaluationStrategy == StrategyCustom {
+		return errors.New("providers cannot be removed when using a custom strategy")
+	}
+	if mp.evaluationStrategy == StrategyShadow && current.providerList[0].Name == name {
+		// removing the primary would silently promote the first shadow to serve the results
+		return fmt.Errorf("provider %s is the primary provider of the shadow strategy and cannot be removed", name)
+	}
+	if policy := mp.config.readinessPolicy; policy != nil {
+		remaining := maps.Clone(current.providers)
+		delete(remaining, name)
+		if err := policy.validate(remaining); err != nil {
+			return fmt.Errorf("provider %s cannot be removed: %w", name, err)
+		}
+	}
 
+	providerList := slices.DeleteFunc(slices.Clone(current.providerList), func(p *strategies.NamedProvider) bool {
+		return p.Name == name
+	})
+	set, err := newProviderSet(mp.evaluationStrategy, providerList, mp.config)
+	if err != nil {
 		return err
 	}
 
+	mp.current.Store(set)
+	mp.stopEventListener(name)
+
 	mp.mu.Lock()
-	defer mp.mu.Unlock()
-	mp.status = of.ReadyState
+	if cancel, ok := mp.initCancels[name]; ok {
+		cancel()
+		delete(mp.initCancels, name)
+	}
+	delete(mp.providerStatus, name)
+	delete(mp.circuitStates, name)
+	previous := mp.status
+	mp.status = mp.evaluateState()
+	overall := mp.status
+	mp.mu.Unlock()
+
+	if stateHandle, ok := provider.(of.StateHandler); ok {
+		stateHandle.Shutdown()
+	}
+
+	mp.logger.LogAttrs(context.Background(), slog.LevelInfo, "provider removed", slog.String(MetadataProviderName, name))
+	mp.publishProviderChange(name, provider, fmt.Sprintf("provider %s removed", name), previous, overall)
 	return nil
 }
 
+// publishProviderChange publishes a configuration change event after a provider was added or removed, as the values of
+// flags might have changed. If the overall state changed as well, an event announcing the new state is published too.
+func (mp *MultiProvider) publishProviderChange(name string, provider of.FeatureProvider, message string, previous of.State, overall of.State) {
+	eventMetadata := map[string]any{
+		MetadataProviderName: name,
+		MetadataProviderType: provider.Metadata().Name,
+	}
+	mp.publishEvent(of.Event{
+		ProviderName: mp.Metadata().Name,
+		EventType:    of.ProviderConfigChange,
+		ProviderEventDetails: of.ProviderEventDetails{
+			Message:       message,
+			EventMetadata: eventMetadata,
+		},
+	})
+
+	eventType, known := stateToEventType[overall]
+	if previous == overall || !known {
+		return
+	}
+	mp.publishEvent(of.Event{
+		ProviderName: mp.Metadata().Name,
+		EventType:    eventType,
+		ProviderEventDetails: of.ProviderEventDetails{
+			Message:       message,
+			EventMetadata: maps.Clone(eventMetadata),
+		},
+	})
+}
+
+// publishEvent publishes an event created by the MultiProvider itself without blocking. Events are dropped if the event
+// channel is full, which is only worth a warning if the events are consumed beyond the OpenFeature SDK.
+func (mp *MultiProvider) publishEvent(e of.Event) {
+	select {
+	case mp.events <- e:
+	default:
+		level := slog.LevelDebug
+		if mp.config.publishEvents {
+			level = slog.LevelWarn
+		}
+		mp.logger.LogAttrs(context.Background(), level, "dropped event, event channel is full",
+			slog.String("event-type", string(e.EventType)),
+			slog.Any(MetadataProviderName, e.EventMetadata[MetadataProviderName]),
+		)
+	}
+}
+
+// startEventListeners starts a worker per internal provider implementing of.EventHandler. Previously started workers
+// are stopped first.
+func (mp *MultiProvider) startEventListeners() {
+	mp.stopEventListeners()
+
+	listenerCtx, shutdownFunc := context.WithCancel(context.Background())
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	mp.listenerCtx = listenerCtx
+	mp.shutdownFunc = shutdownFunc
+	for _, p := range mp.current.Load().providerList {
+		mp.startEventListener(p)
+	}
+}
+
+// startEventListener starts the worker of a single internal provider implementing of.EventHandler, unless listening to
+// events has not been started yet. The lock must be held by the caller.
+func (mp *MultiProvider) startEventListener(p *strategies.NamedProvider) {
+	if mp.listenerCtx == nil {
+		return
+	}
+	handler, ok := p.Provider.(of.EventHandler)
+	if !ok {
+		return
+	}
+	ctx, cancel := context.WithCancel(mp.listenerCtx)
+	listener := &eventListener{cancel: cancel, done: make(chan struct{})}
+	mp.listeners[p.Name] = listener
+	mp.workerGroup.Add(1)
+	go func() {
+		defer close(listener.done)
+		mp.forwardProviderEvents(ctx, p.Name, p.Provider, handler.EventChannel())
+	}()
+}
+
+// stopEventListener stops the worker of a single internal provider and waits for it to return
+func (mp *MultiProvider) stopEventListener(name string) {
+	mp.mu.Lock()
+	listener, ok := mp.listeners[name]
+	delete(mp.listeners, name)
+	mp.mu.Unlock()
+
+	if ok {
+		listener.cancel()
+		<-listener.done
+	}
+}
+
+// stopEventListeners stops all event workers and waits for them to return
+func (mp *MultiProvider) stopEventListeners() {
+	mp.mu.Lock()
+	shutdownFunc := mp.shutdownFunc
+	mp.shutdownFunc = nil
+	mp.listenerCtx = nil
+	clear(mp.listeners)
+	mp.mu.Unlock()
+
+	if shutdownFunc != nil {
+		shutdownFunc()
+	}
+	mp.workerGroup.Wait()
+}
+
+// forwardProviderEvents listens to the events of a single internal provider until the context is cancelled or the
+// channel is closed. State events are forwarded if they change the overall state of the MultiProvider, configuration
+// change events only if event publishing is enabled. Forwarded events are tagged with the name of the internal provider.
+func (mp *MultiProvider) forwardProviderEvents(ctx context.Context, name string, provider of.FeatureProvider, events <-chan of.Event) {
+	defer mp.workerGroup.Done()
+
+	l := mp.logger.With(slog.String(MetadataProviderName, name))
+	for {
+		var e of.Event
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return
+		case e, ok = <-events:
+			if !ok {
+				return
+			}
+		}
+
+		l.LogAttrs(ctx, slog.LevelDebug, "received event from provider", slog.String("event-type", string(e.EventType)))
+		eventMetadata := make(map[string]any, len(e.EventMetadata)+2)
+		maps.Copy(eventMetadata, e.EventMetadata)
+		eventMetadata[MetadataProviderName] = name
+		eventMetadata[MetadataProviderType] = provider.Metadata().Name
+		e.EventMetadata = eventMetadata
+
+		if e.EventType == of.ProviderConfigChange && !mp.config.publishEvents {
+			continue
+		}
+		if e.EventType != of.ProviderConfigChange {
+			state, known := eventTypeToState[e.EventType]
+			if !known {
+				l.LogAttrs(ctx, slog.LevelWarn, "ignoring event of unknown type", slog.String("event-type", string(e.EventType)))
+				continue
+			}
+			if state == of.ErrorState && e.ErrorCode == of.ProviderFatalCode {
+				state = of.FatalState
+			}
+			logProviderState(l, state, e.Message)
+
+			overall, changed := mp.updateProviderState(name, state)
+			if !changed {
+				continue
+			}
+			eventType, known := stateToEventType[overall]
+			if !known {
+				continue
+			}
+			e.EventType = eventType
+		}
+
+		if !mp.config.publishEvents {
+			// nobody but the OpenFeature SDK might consume the events, so the state tracking must not block on them
+			mp.publishEvent(e)
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case mp.events <- e:
+		}
+	}
+}
+
+// updateProviderState sets the state of an internal provider and re-evaluates the overall state of the MultiProvider.
+// The overall state is returned together with a flag reporting whether it changed. Providers that have been removed are
+// ignored.
+func (mp *MultiProvider) updateProviderState(name string, state of.State) (of.State, bool) {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	if _, known := mp.providerStatus[name]; !known {
+		return mp.status, false
+	}
+	mp.providerStatus[name] = state
+	previous := mp.status
+	mp.status = mp.evaluateState()
+	return mp.status, previous != mp.status
+}
+
+// updateCircuitState records the new state of the circuit breaker of an internal provider and re-evaluates the overall
+// state of the MultiProvider. If the overall state changed, it is published as an event of the type matching the new
+// overall state, tagged with the name of the internal provider and the new circuit state.
+func (mp *MultiProvider) updateCircuitState(name string, provider of.FeatureProvider, from strategies.CircuitState, to strategies.CircuitState) {
+	level := slog.LevelInfo
+	if to == strategies.CircuitOpen {
+		level = slog.LevelWarn
+	}
+	mp.logger.LogAttrs(context.Background(), level, "circuit breaker state changed",
+		slog.String(MetadataProviderName, name),
+		slog.String("from", string(from)),
+		slog.String("to", string(to)),
+	)
+
+	mp.mu.Lock()
+	if _, known := mp.circuitStates[name]; !known {
+		// the provider has been removed
+		mp.mu.Unlock()
+		return
+	}
+	mp.circuitStates[name] = to
+	previous := mp.status
+	mp.status = mp.evaluateState()
+	overall := mp.status
+	mp.mu.Unlock()
+
+	eventType, known := stateToEventType[overall]
+	if previous == overall || !known {
+		return
+	}
+	// circuit state changes happen during evaluations, which must never block on a full event channel
+	mp.publishEvent(of.Event{
+		ProviderName: mp.Metadata().Name,
+		EventType:    eventType,
+		ProviderEventDetails: of.ProviderEventDetails{
+			Message: fmt.Sprintf("circuit breaker of provider %s changed from %s to %s", name, from, to),
+			EventMetadata: map[string]any{
+				MetadataProviderName: name,
+				MetadataProviderType: provider.Metadata().Name,
+				MetadataCircuitState: string(to),
+			},
+		},
+	})
+}
+
+// evaluateState determines the overall state from the states of all internal providers. If the readiness policy is
+// satisfied the MultiProvider is ready, otherwise a fatal or errored provider results in ErrorState, followed by
+// NotReadyState, StaleState and finally ReadyState. A ready provider whose circuit breaker is open counts as stale. The
+// lock must be held by the caller.
+func (mp *MultiProvider) evaluateState() of.State {
+	overall := of.ReadyState
+	states := make(map[string]of.State, len(mp.providerStatus))
+	for name, state := range mp.providerStatus {
+		if state == of.ReadyState && mp.circuitStates[name] == strategies.CircuitOpen {
+			state = of.StaleState
+		}
+		states[name] = state
+		if stateValues[state] > stateValues[overall] {
+			overall = state
+		}
+	}
+	if policy := mp.config.readinessPolicy; policy != nil && policy.satisfied(states) {
+		return of.ReadyState
+	}
+	if overall == of.FatalState {
+		return of.ErrorState
+	}
+	return overall
+}
+
+func logProviderState(l *slog.Logger, state of.State, message string) {
+	switch state {
+	case of.ReadyState:
+		l.LogAttrs(context.Background(), slog.LevelDebug, "provider is ready")
+	case of.StaleState:
+		l.LogAttrs(context.Background(), slog.LevelWarn, "provider is stale", slog.String("event-message", message))
+	case of.ErrorState, of.FatalState:
+		l.LogAttrs(context.Background(), slog.LevelError, "provider is in an error state", slog.String("event-message", message))
+	}
+}
+
+// ProviderStatus Returns the last known state of every internal provider by name
+func (mp *MultiProvider) ProviderStatus() map[string]of.State {
+	mp.mu.RLock()
+	defer mp.mu.RUnlock()
+	return maps.Clone(mp.providerStatus)
+}
+
+// CircuitStates Returns the state of the circuit breaker of every internal provider by name. Providers without a
+// circuit breaker are always closed.
+func (mp *MultiProvider) CircuitStates() map[string]strategies.CircuitState {
+	mp.mu.RLock()
+	defer mp.mu.RUnlock()
+	return maps.Clone(mp.circuitStates)
+}
+
 // Status the current status of the MultiProvider
 func (mp *MultiProvider) Status() of.State {
 	mp.mu.RLock()
@@ -262,23 +889,34 @@ func (mp *MultiProvider) Status() of.State {
 	return mp.status
 }
 
-// Shutdown Shuts down all internal providers
+// Shutdown Shuts down all internal providers and stops listening to their events
 func (mp *MultiProvider) Shutdown() {
+	mp.stopInitialization()
+	mp.stopEventListeners()
+
 	var wg sync.WaitGroup
-	for _, provider := range mp.providers {
+	for _, np := range mp.current.Load().providerList {
 		wg.Add(1)
 		go func(p of.FeatureProvider) {
 			defer wg.Done()
-			if stateHandle, ok := provider.(of.StateHandler); ok {
+			if stateHandle, ok := p.(of.StateHandler); ok {
 				stateHandle.Shutdown()
 			}
-		}(provider)
+		}(np.Provider)
 	}
 
 	wg.Wait()
+
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	for name := range mp.providerStatus {
+		mp.providerStatus[name] = of.NotReadyState
+	}
+	mp.status = of.NotReadyState
 }
 
-// EventChannel the channel events are emitted on (Not Yet Implemented)
+// EventChannel the channel events are emitted on. Changes of the overall state are always published, the configuration
+// change events of internal providers only if WithEventPublishing is set.
 func (mp *MultiProvider) EventChannel() <-chan of.Event {
 	return mp.events
 }
@@ -1,9 +1,13 @@
 package multiprovider
 
 import (
+	"context"
 	"errors"
 	"regexp"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/open-feature/go-sdk-contrib/providers/multi-provider/internal/mocks"
 	"github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg/strategies"
@@ -75,6 +79,13 @@ func TestMultiProvider_NewMultiProvider(t *testing.T) {
 		assert.NotZero(t, mp)
 	})
 
+	t.Run("flag key routing with unknown provider returns an error", func(t *testing.T) {
+		providers := make(ProviderMap)
+		providers["provider1"] = imp.NewInMemoryProvider(map[string]imp.InMemoryFlag{})
+		_, err := NewMultiProvider(providers, StrategyFlagKeyRouting, WithFlagKeyRoutes("provider1", strategies.PrefixKeyRoute("billing.", "ssm")))
+		require.EqualError(t, err, "route prefix:billing. references unknown provider ssm")
+	})
+
 	t.Run("success with custom provider", func(t *testing.T) {
 		providers := make(ProviderMap)
 		providers["provider1"] = imp.NewInMemoryProvider(map[string]imp.InMemoryFlag{})
@@ -86,6 +97,52 @@ func TestMultiProvider_NewMultiProvider(t *testing.T) {
 	})
 }
 
+func TestMultiProvider_NewOrderedMultiProvider(t *testing.T) {
+	t.Run("empty providers returns an error", func(t *testing.T) {
+		_, err := NewOrderedMultiProvider(nil, strategies.StrategyFirstMatch)
+		require.EqualError(t, err, "providers cannot be nil or empty")
+	})
+
+	t.Run("duplicate provider name returns an error", func(t *testing.T) {
+		_, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "provider1", Provider: imp.NewInMemoryProvider(map[string]imp.InMemoryFlag{})},
+			{Name: "provider1", Provider: imp.NewInMemoryProvider(map[string]imp.InMemoryFlag{})},
+		}, strategies.StrategyFirstMatch)
+		require.EqualError(t, err, "provider name provider1 is used more than once")
+	})
+
+	t.Run("nil provider returns an error", func(t *testing.T) {
+		_, err := NewOrderedMultiProvider([]*strategies.NamedProvider{{Name: "provider1"}}, strategies.StrategyFirstMatch)
+		require.EqualError(t, err, "provider provider1 cannot be nil")
+	})
+
+	t.Run("order is retained", func(t *testing.T) {
+		local := imp.NewInMemoryProvider(map[string]imp.InMemoryFlag{
+			"flag": {Key: "flag", State: imp.Enabled, DefaultVariant: "on", Variants: map[string]any{"on": "local"}},
+		})
+		flagd := imp.NewInMemoryProvider(map[string]imp.InMemoryFlag{
+			"flag": {Key: "flag", State: imp.Enabled, DefaultVariant: "on", Variants: map[string]any{"on": "flagd"}},
+		})
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "local", Provider: local},
+			{Name: "flagd", Provider: flagd},
+		}, strategies.StrategyFirstMatch)
+		require.NoError(t, err)
+
+		p := mp.Providers()
+		require.Len(t, p, 2)
+		assert.Equal(t, "local", p[0].Name)
+		assert.Equal(t, "flagd", p[1].Name)
+		assert.Equal(t, "MultiProvider {local: InMemoryProvider, flagd: InMemoryProvider}", mp.Metadata().Name)
+
+		for range 10 {
+			result := mp.StringEvaluation(context.Background(), "flag", "default", of.FlattenedContext{})
+			assert.Equal(t, "local", result.Value)
+			assert.Equal(t, "local", result.FlagMetadata[strategies.MetadataSuccessfulProviderName])
+		}
+	})
+}
+
 func TestMultiProvider_ProvidersByNamesMethod(t *testing.T) {
 	testProvider1 := imp.NewInMemoryProvider(map[string]imp.InMemoryFlag{})
 	testProvider2 := imp.NewInMemoryProvider(map[string]imp.InMemoryFlag{})
@@ -207,3 +264,600 @@ func TestMultiProvider_Shutdown(t *testing.T) {
 
 	mp.Shutdown()
 }
+
+type eventingProvider struct {
+	of.FeatureProvider
+	events chan of.Event
+}
+
+func (p *eventingProvider) EventChannel() <-chan of.Event {
+	return p.events
+}
+
+func newEventingProvider() *eventingProvider {
+	return &eventingProvider{
+		FeatureProvider: imp.NewInMemoryProvider(map[string]imp.InMemoryFlag{}),
+		events:          make(chan of.Event),
+	}
+}
+
+func TestMultiProvider_Events(t *testing.T) {
+	t.Run("forwards state changes and configuration changes tagged with the provider name", func(t *testing.T) {
+		provider1 := newEventingProvider()
+		provider2 := newEventingProvider()
+
+		providers := make(ProviderMap)
+		providers["provider1"] = provider1
+		providers["provider2"] = provider2
+
+		mp, err := NewMultiProvider(providers, strategies.StrategyFirstMatch, WithEventPublishing())
+		require.NoError(t, err)
+		require.NoError(t, mp.Init(of.EvaluationContext{}))
+		defer mp.Shutdown()
+		assert.Equal(t, of.ReadyState, mp.Status())
+
+		provider1.events <- of.Event{ProviderName: "InMemoryProvider", EventType: of.ProviderStale}
+		e := <-mp.EventChannel()
+		assert.Equal(t, of.ProviderStale, e.EventType)
+		assert.Equal(t, "provider1", e.EventMetadata[MetadataProviderName])
+		assert.Equal(t, "InMemoryProvider", e.EventMetadata[MetadataProviderType])
+		assert.Equal(t, of.StaleState, mp.Status())
+
+		provider2.events <- of.Event{EventType: of.ProviderError, ProviderEventDetails: of.ProviderEventDetails{Message: "stream lost"}}
+		e = <-mp.EventChannel()
+		assert.Equal(t, of.ProviderError, e.EventType)
+		assert.Equal(t, "provider2", e.EventMetadata[MetadataProviderName])
+		assert.Equal(t, "stream lost", e.Message)
+		assert.Equal(t, of.ErrorState, mp.Status())
+
+		provider1.events <- of.Event{EventType: of.ProviderConfigChange, ProviderEventDetails: of.ProviderEventDetails{FlagChanges: []string{"flag"}}}
+		e = <-mp.EventChannel()
+		assert.Equal(t, of.ProviderConfigChange, e.EventType)
+		assert.Equal(t, []string{"flag"}, e.FlagChanges)
+		assert.Equal(t, "provider1", e.EventMetadata[MetadataProviderName])
+
+		provider2.events <- of.Event{EventType: of.ProviderReady}
+		e = <-mp.EventChannel()
+		assert.Equal(t, of.ProviderStale, e.EventType, "overall state is still stale because of provider1")
+		assert.Equal(t, "provider2", e.EventMetadata[MetadataProviderName])
+		assert.Equal(t, of.StaleState, mp.Status())
+
+		provider1.events <- of.Event{EventType: of.ProviderReady}
+		e = <-mp.EventChannel()
+		assert.Equal(t, of.ProviderReady, e.EventType)
+		assert.Equal(t, of.ReadyState, mp.Status())
+		assert.Equal(t, map[string]of.State{"provider1": of.ReadyState, "provider2": of.ReadyState}, mp.ProviderStatus())
+	})
+
+	t.Run("fatal provider results in error state", func(t *testing.T) {
+		provider1 := newEventingProvider()
+		providers := make(ProviderMap)
+		providers["provider1"] = provider1
+		providers["provider2"] = imp.NewInMemoryProvider(map[string]imp.InMemoryFlag{})
+
+		mp, err := NewMultiProvider(providers, strategies.StrategyFirstMatch, WithEventPublishing())
+		require.NoError(t, err)
+		require.NoError(t, mp.Init(of.EvaluationContext{}))
+		defer mp.Shutdown()
+
+		provider1.events <- of.Event{EventType: of.ProviderError, ProviderEventDetails: of.ProviderEventDetails{ErrorCode: of.ProviderFatalCode}}
+		e := <-mp.EventChannel()
+		assert.Equal(t, of.ProviderError, e.EventType)
+		assert.Equal(t, of.ErrorState, mp.Status())
+		assert.Equal(t, of.FatalState, mp.ProviderStatus()["provider1"])
+	})
+
+	t.Run("status is tracked without event publishing", func(t *testing.T) {
+		provider1 := newEventingProvider()
+		providers := make(ProviderMap)
+		providers["provider1"] = provider1
+
+		mp, err := NewMultiProvider(providers, strategies.StrategyFirstMatch)
+		require.NoError(t, err)
+		require.NotNil(t, mp.EventChannel())
+		assert.Equal(t, of.NotReadyState, mp.Status())
+		require.NoError(t, mp.Init(of.EvaluationContext{}))
+
+		provider1.events <- of.Event{EventType: of.ProviderConfigChange}
+		provider1.events <- of.Event{EventType: of.ProviderStale}
+		assert.Eventually(t, func() bool {
+			return mp.Status() == of.StaleState
+		}, time.Second, 10*time.Millisecond)
+
+		// state changes are published, configuration changes of internal providers are not
+		e := <-mp.EventChannel()
+		assert.Equal(t, of.ProviderStale, e.EventType)
+		assert.Empty(t, mp.EventChannel())
+
+		mp.Shutdown()
+		assert.Equal(t, of.NotReadyState, mp.Status())
+	})
+}
+
+func TestMultiProvider_CircuitBreaker(t *testing.T) {
+	healthy := imp.NewInMemoryProvider(map[string]imp.InMemoryFlag{
+		"flag": {
+			State:          imp.Enabled,
+			DefaultVariant: "on",
+			Variants:       map[string]any{"on": true},
+		},
+	})
+	mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+		{Name: "failing", Provider: &failingProvider{FeatureProvider: imp.NewInMemoryProvider(nil)}},
+		{Name: "failing2", Provider: &failingProvider{FeatureProvider: imp.NewInMemoryProvider(nil)}},
+		{Name: "healthy", Provider: healthy},
+	}, StrategyFirstMatch, WithEventPublishing(), WithCircuitBreaker(strategies.CircuitBreakerConfig{
+		WindowSize:   1,
+		OpenDuration: time.Minute,
+	}))
+	require.NoError(t, err)
+	require.NoError(t, mp.Init(of.EvaluationContext{}))
+	defer mp.Shutdown()
+
+	result := mp.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+	assert.Equal(t, of.GeneralCode, result.ResolutionDetail().ErrorCode)
+
+	e := <-mp.EventChannel()
+	assert.Equal(t, of.ProviderStale, e.EventType)
+	assert.Equal(t, "failing", e.EventMetadata[MetadataProviderName])
+	assert.Equal(t, string(strategies.CircuitOpen), e.EventMetadata[MetadataCircuitState])
+	assert.Equal(t, of.StaleState, mp.Status())
+	assert.Equal(t, strategies.CircuitOpen, mp.CircuitStates()["failing"])
+
+	// the second circuit opening does not change the overall state, so no event is published
+	result = mp.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+	assert.Equal(t, of.GeneralCode, result.ResolutionDetail().ErrorCode)
+	assert.Equal(t, strategies.CircuitOpen, mp.CircuitStates()["failing2"])
+	assert.Equal(t, of.StaleState, mp.Status())
+	assert.Empty(t, mp.EventChannel())
+	assert.Equal(t, strategies.CircuitClosed, mp.CircuitStates()["healthy"])
+
+	result = mp.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+	assert.True(t, result.Value)
+	assert.Equal(t, "healthy", result.FlagMetadata[strategies.MetadataSuccessfulProviderName])
+	assert.Equal(t, "failing, failing2", result.FlagMetadata[strategies.MetadataSkippedProviders])
+}
+
+func TestMultiProvider_AddRemoveProvider(t *testing.T) {
+	flags := func(value bool) map[string]imp.InMemoryFlag {
+		return map[string]imp.InMemoryFlag{
+			"flag": {State: imp.Enabled, DefaultVariant: "on", Variants: map[string]any{"on": value}},
+		}
+	}
+
+	t.Run("added provider is initialized and used", func(t *testing.T) {
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "base", Provider: imp.NewInMemoryProvider(nil)},
+		}, StrategyFirstMatch, WithEventPublishing())
+		require.NoError(t, err)
+		require.NoError(t, mp.Init(of.EvaluationContext{}))
+		defer mp.Shutdown()
+
+		result := mp.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+		assert.Equal(t, of.FlagNotFoundCode, result.ResolutionDetail().ErrorCode)
+
+		vendor := &lifecycleProvider{FeatureProvider: imp.NewInMemoryProvider(flags(true))}
+		require.NoError(t, mp.AddProvider("vendor", vendor))
+		assert.Equal(t, 1, vendor.inits)
+		assert.Equal(t, "MultiProvider {base: InMemoryProvider, vendor: InMemoryProvider}", mp.Metadata().Name)
+		assert.Equal(t, of.ReadyState, mp.ProviderStatus()["vendor"])
+		assert.Len(t, mp.Providers(), 2)
+
+		e := <-mp.EventChannel()
+		assert.Equal(t, of.ProviderConfigChange, e.EventType)
+		assert.Equal(t, "vendor", e.EventMetadata[MetadataProviderName])
+
+		result = mp.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+		assert.True(t, result.Value)
+		assert.Equal(t, "vendor", result.FlagMetadata[strategies.MetadataSuccessfulProviderName])
+	})
+
+	t.Run("provider failing to initialize is not added", func(t *testing.T) {
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "base", Provider: imp.NewInMemoryProvider(nil)},
+		}, StrategyFirstMatch)
+		require.NoError(t, err)
+		require.NoError(t, mp.Init(of.EvaluationContext{}))
+		defer mp.Shutdown()
+
+		err = mp.AddProvider("vendor", &lifecycleProvider{FeatureProvider: imp.NewInMemoryProvider(nil), initErr: errors.New("unreachable")})
+		assert.ErrorContains(t, err, "Provider vendor: unreachable")
+		assert.Len(t, mp.Providers(), 1)
+		assert.NotContains(t, mp.ProviderStatus(), "vendor")
+	})
+
+	t.Run("provider hanging on initialization times out", func(t *testing.T) {
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "base", Provider: imp.NewInMemoryProvider(nil)},
+		}, StrategyFirstMatch, WithInitTimeout(10*time.Millisecond))
+		require.NoError(t, err)
+		require.NoError(t, mp.Init(of.EvaluationContext{}))
+		defer mp.Shutdown()
+
+		err = mp.AddProvider("vendor", &initProvider{FeatureProvider: imp.NewInMemoryProvider(nil), delay: time.Hour})
+		assert.ErrorContains(t, err, "Provider vendor: initialization timed out after 10ms")
+		assert.Len(t, mp.Providers(), 1)
+		assert.NotContains(t, mp.ProviderStatus(), "vendor")
+		assert.Equal(t, of.ReadyState, mp.Status())
+	})
+
+	t.Run("failing provider tolerated by the readiness policy is added and retried", func(t *testing.T) {
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "base", Provider: imp.NewInMemoryProvider(nil)},
+		}, StrategyFirstMatch, WithReadinessPolicy(ReadyWhenPrimary("base")), WithInitRetryInterval(time.Millisecond))
+		require.NoError(t, err)
+		require.NoError(t, mp.Init(of.EvaluationContext{}))
+		defer mp.Shutdown()
+
+		vendor := &initProvider{FeatureProvider: imp.NewInMemoryProvider(flags(true)), failures: 1}
+		require.NoError(t, mp.AddProvider("vendor", vendor))
+		assert.Len(t, mp.Providers(), 2)
+		assert.Equal(t, of.ReadyState, mp.Status())
+		assert.Eventually(t, func() bool {
+			return mp.ProviderStatus()["vendor"] == of.ReadyState
+		}, time.Second, time.Millisecond)
+		assert.Equal(t, int32(2), vendor.inits.Load())
+	})
+
+	t.Run("invalid additions return an error", func(t *testing.T) {
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "base", Provider: imp.NewInMemoryProvider(nil)},
+		}, StrategyFirstMatch)
+		require.NoError(t, err)
+
+		assert.ErrorContains(t, mp.AddProvider("base", imp.NewInMemoryProvider(nil)), "used more than once")
+		assert.Error(t, mp.AddProvider("", imp.NewInMemoryProvider(nil)))
+		assert.Error(t, mp.AddProvider("vendor", nil))
+	})
+
+	t.Run("removed provider is shut down and no longer used", func(t *testing.T) {
+		vendor := &lifecycleProvider{FeatureProvider: imp.NewInMemoryProvider(flags(true))}
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "vendor", Provider: vendor},
+			{Name: "base", Provider: imp.NewInMemoryProvider(flags(false))},
+		}, StrategyFirstMatch)
+		require.NoError(t, err)
+		require.NoError(t, mp.Init(of.EvaluationContext{}))
+		defer mp.Shutdown()
+		assert.True(t, mp.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{}).Value)
+
+		require.NoError(t, mp.RemoveProvider("vendor"))
+		assert.Equal(t, 1, vendor.shutdowns)
+		assert.Equal(t, "MultiProvider {base: InMemoryProvider}", mp.Metadata().Name)
+		assert.NotContains(t, mp.ProvidersByName(), "vendor")
+		assert.NotContains(t, mp.ProviderStatus(), "vendor")
+		assert.False(t, mp.BooleanEvaluation(context.Background(), "flag", true, of.FlattenedContext{}).Value)
+
+		assert.ErrorContains(t, mp.RemoveProvider("vendor"), "not a known provider")
+		assert.ErrorContains(t, mp.RemoveProvider("base"), "last provider")
+	})
+
+	t.Run("removing an errored provider restores the overall state", func(t *testing.T) {
+		broken := &lifecycleProvider{FeatureProvider: imp.NewInMemoryProvider(nil), initErr: errors.New("unreachable")}
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "broken", Provider: broken},
+			{Name: "base", Provider: imp.NewInMemoryProvider(nil)},
+		}, StrategyFirstMatch)
+		require.NoError(t, err)
+		require.Error(t, mp.Init(of.EvaluationContext{}))
+		defer mp.Shutdown()
+		assert.Equal(t, of.ErrorState, mp.Status())
+
+		require.NoError(t, mp.RemoveProvider("broken"))
+		assert.Equal(t, of.ReadyState, mp.Status())
+	})
+
+	t.Run("primary shadow provider cannot be removed", func(t *testing.T) {
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "primary", Provider: imp.NewInMemoryProvider(nil)},
+			{Name: "shadow", Provider: imp.NewInMemoryProvider(nil)},
+			{Name: "other", Provider: imp.NewInMemoryProvider(nil)},
+		}, StrategyShadow)
+		require.NoError(t, err)
+
+		assert.ErrorContains(t, mp.RemoveProvider("primary"), "primary provider of the shadow strategy")
+		assert.Len(t, mp.Providers(), 3)
+		require.NoError(t, mp.RemoveProvider("shadow"))
+		assert.Equal(t, "primary", mp.Providers()[0].Name)
+	})
+
+	t.Run("rebuilding an invalid strategy keeps the providers", func(t *testing.T) {
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "a", Provider: imp.NewInMemoryProvider(nil)},
+			{Name: "b", Provider: imp.NewInMemoryProvider(nil)},
+		}, StrategyFlagKeyRouting, WithFlagKeyRoutes("b", strategies.PrefixKeyRoute("a-", "a")))
+		require.NoError(t, err)
+
+		assert.ErrorContains(t, mp.RemoveProvider("a"), "a")
+		assert.Len(t, mp.Providers(), 2)
+	})
+
+	t.Run("concurrent evaluations", func(t *testing.T) {
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "base", Provider: imp.NewInMemoryProvider(flags(true))},
+		}, StrategyFirstSuccess)
+		require.NoError(t, err)
+		require.NoError(t, mp.Init(of.EvaluationContext{}))
+		defer mp.Shutdown()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		var wg sync.WaitGroup
+		for range 4 {
+			wg.Go(func() {
+				for ctx.Err() == nil {
+					assert.True(t, mp.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{}).Value)
+				}
+			})
+		}
+		for range 20 {
+			require.NoError(t, mp.AddProvider("vendor", imp.NewInMemoryProvider(flags(true))))
+			require.NoError(t, mp.RemoveProvider("vendor"))
+		}
+		cancel()
+		wg.Wait()
+	})
+}
+
+func TestMultiProvider_ReadinessPolicy(t *testing.T) {
+	t.Run("invalid policies", func(t *testing.T) {
+		providers := []*strategies.NamedProvider{
+			{Name: "a", Provider: imp.NewInMemoryProvider(nil)},
+			{Name: "b", Provider: imp.NewInMemoryProvider(nil)},
+		}
+		_, err := NewOrderedMultiProvider(providers, StrategyFirstMatch, WithReadinessPolicy(ReadyWhenPrimary("c")))
+		assert.ErrorContains(t, err, "primary provider c is not a known provider")
+		_, err = NewOrderedMultiProvider(providers, StrategyFirstMatch, WithReadinessPolicy(ReadyWhenCount(3)))
+		assert.ErrorContains(t, err, "3 ready providers required")
+		_, err = NewOrderedMultiProvider(providers, StrategyFirstMatch, WithReadinessPolicy(ReadyWhenCount(-1)))
+		assert.ErrorContains(t, err, "cannot be negative")
+	})
+
+	t.Run("failing provider does not block ready when any", func(t *testing.T) {
+		vendor := &initProvider{FeatureProvider: imp.NewInMemoryProvider(nil), failures: -1}
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "base", Provider: imp.NewInMemoryProvider(nil)},
+			{Name: "vendor", Provider: vendor},
+		}, StrategyFirstMatch, WithReadinessPolicy(ReadyWhenAny()), WithInitRetryInterval(-1))
+		require.NoError(t, err)
+		require.NoError(t, mp.Init(of.EvaluationContext{}))
+		defer mp.Shutdown()
+
+		assert.Equal(t, of.ReadyState, mp.Status())
+		assert.Eventually(t, func() bool {
+			return mp.ProviderStatus()["vendor"] == of.ErrorState
+		}, time.Second, time.Millisecond)
+		assert.Equal(t, of.ReadyState, mp.Status())
+	})
+
+	t.Run("slow provider times out when primary is ready", func(t *testing.T) {
+		vendor := &initProvider{FeatureProvider: imp.NewInMemoryProvider(nil), delay: time.Hour}
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "base", Provider: imp.NewInMemoryProvider(nil)},
+			{Name: "vendor", Provider: vendor},
+		}, StrategyFirstMatch, WithReadinessPolicy(ReadyWhenPrimary("base")), WithInitTimeout(10*time.Millisecond))
+		require.NoError(t, err)
+
+		start := time.Now()
+		require.NoError(t, mp.Init(of.EvaluationContext{}))
+		defer mp.Shutdown()
+		assert.Less(t, time.Since(start), time.Second)
+		assert.Equal(t, of.ReadyState, mp.Status())
+		assert.ErrorContains(t, mp.RemoveProvider("base"), "primary provider base is not a known provider")
+	})
+
+	t.Run("per provider init timeouts override the init timeout", func(t *testing.T) {
+		base := &initProvider{FeatureProvider: imp.NewInMemoryProvider(nil), delay: 50 * time.Millisecond}
+		vendor := &initProvider{FeatureProvider: imp.NewInMemoryProvider(nil), delay: time.Hour}
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "base", Provider: base},
+			{Name: "vendor", Provider: vendor},
+		}, StrategyFirstMatch, WithReadinessPolicy(ReadyWhenPrimary("base")), WithInitTimeout(10*time.Millisecond),
+			WithProviderInitTimeouts(map[string]time.Duration{"base": time.Second}))
+		require.NoError(t, err)
+
+		require.NoError(t, mp.Init(of.EvaluationContext{}))
+		defer mp.Shutdown()
+		assert.Equal(t, of.ReadyState, mp.ProviderStatus()["base"])
+		assert.Equal(t, of.ErrorState, mp.ProviderStatus()["vendor"])
+	})
+
+	t.Run("invalid per provider init timeouts", func(t *testing.T) {
+		providers := []*strategies.NamedProvider{{Name: "a", Provider: imp.NewInMemoryProvider(nil)}}
+		_, err := NewOrderedMultiProvider(providers, StrategyFirstMatch, WithProviderInitTimeouts(map[string]time.Duration{"b": time.Second}))
+		assert.ErrorContains(t, err, "init timeout provider b is not a known provider")
+		_, err = NewOrderedMultiProvider(providers, StrategyFirstMatch, WithProviderInitTimeouts(map[string]time.Duration{"a": -time.Second}))
+		assert.ErrorContains(t, err, "init timeout of provider a cannot be negative")
+	})
+
+	t.Run("per provider init timeouts are copied", func(t *testing.T) {
+		timeouts := map[string]time.Duration{"a": time.Second}
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{{Name: "a", Provider: imp.NewInMemoryProvider(nil)}},
+			StrategyFirstMatch, WithProviderInitTimeouts(timeouts))
+		require.NoError(t, err)
+		timeouts["a"] = time.Hour
+		assert.Equal(t, time.Second, mp.config.providerInitTimeouts["a"])
+	})
+
+	t.Run("unsatisfied policy returns the errors", func(t *testing.T) {
+		primary := &initProvider{FeatureProvider: imp.NewInMemoryProvider(nil), failures: -1}
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "primary", Provider: primary},
+			{Name: "base", Provider: imp.NewInMemoryProvider(nil)},
+		}, StrategyFirstMatch, WithReadinessPolicy(ReadyWhenPrimary("primary")), WithInitRetryInterval(-1))
+		require.NoError(t, err)
+		err = mp.Init(of.EvaluationContext{})
+		defer mp.Shutdown()
+
+		assert.EqualError(t, err, "Provider primary: init failed")
+		assert.Equal(t, of.ErrorState, mp.Status())
+	})
+
+	t.Run("failed providers are retried and rejoin", func(t *testing.T) {
+		vendor := &initProvider{FeatureProvider: imp.NewInMemoryProvider(nil), failures: 2}
+		other := &initProvider{FeatureProvider: imp.NewInMemoryProvider(nil), failures: 1}
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "base", Provider: imp.NewInMemoryProvider(nil)},
+			{Name: "vendor", Provider: vendor},
+			{Name: "other", Provider: other},
+		}, StrategyFirstMatch, WithReadinessPolicy(ReadyWhenCount(3)), WithInitRetryInterval(time.Millisecond), WithEventPublishing())
+		require.NoError(t, err)
+		require.Error(t, mp.Init(of.EvaluationContext{}))
+		defer mp.Shutdown()
+
+		e := <-mp.EventChannel()
+		for e.EventType != of.ProviderReady {
+			e = <-mp.EventChannel()
+		}
+		assert.Equal(t, of.ReadyState, mp.Status())
+		assert.Equal(t, int32(3), vendor.inits.Load())
+		assert.Equal(t, int32(2), other.inits.Load())
+	})
+
+	t.Run("shutdown stops retrying", func(t *testing.T) {
+		vendor := &initProvider{FeatureProvider: imp.NewInMemoryProvider(nil), failures: -1}
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "base", Provider: imp.NewInMemoryProvider(nil)},
+			{Name: "vendor", Provider: vendor},
+		}, StrategyFirstMatch, WithReadinessPolicy(ReadyWhenAny()), WithInitRetryInterval(time.Millisecond))
+		require.NoError(t, err)
+		require.NoError(t, mp.Init(of.EvaluationContext{}))
+		mp.Shutdown()
+
+		inits := vendor.inits.Load()
+		time.Sleep(20 * time.Millisecond)
+		assert.Equal(t, inits, vendor.inits.Load())
+	})
+}
+
+func TestMultiProvider_Observer(t *testing.T) {
+	var mu sync.Mutex
+	var observations []strategies.Observation
+	observer := strategies.ObserverFunc(func(_ context.Context, o strategies.Observation) {
+		mu.Lock()
+		defer mu.Unlock()
+		observations = append(observations, o)
+	})
+
+	mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+		{Name: "empty", Provider: imp.NewInMemoryProvider(nil)},
+		{Name: "flags", Provider: imp.NewInMemoryProvider(map[string]imp.InMemoryFlag{
+			"flag": {State: imp.Enabled, DefaultVariant: "on", Variants: map[string]any{"on": true}},
+		})},
+	}, StrategyFirstMatch, WithObserver(observer))
+	require.NoError(t, err)
+	require.NoError(t, mp.Init(of.EvaluationContext{}))
+	defer mp.Shutdown()
+
+	assert.True(t, mp.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{}).Value)
+	assert.Equal(t, StrategyFirstMatch, mp.EvaluationStrategy())
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, observations, 2)
+	assert.Equal(t, "empty", observations[0].ProviderName)
+	assert.Equal(t, of.FlagNotFoundCode, observations[0].ErrorCode)
+	assert.False(t, observations[0].Selected)
+	assert.Equal(t, "flags", observations[1].ProviderName)
+	assert.True(t, observations[1].Selected)
+}
+
+func TestMultiProvider_Hooks(t *testing.T) {
+	providerHook := &countingHook{}
+	globalHook := &countingHook{}
+	provider := &hookProvider{
+		FeatureProvider: imp.NewInMemoryProvider(map[string]imp.InMemoryFlag{
+			"flag": {Key: "flag", State: imp.Enabled, DefaultVariant: "on", Variants: map[string]any{"on": true}},
+		}),
+		hooks: []of.Hook{providerHook},
+	}
+
+	providers := make(ProviderMap)
+	providers["provider1"] = provider
+	mp, err := NewMultiProvider(providers, strategies.StrategyFirstMatch, WithHooks(globalHook))
+	require.NoError(t, err)
+	assert.Equal(t, []of.Hook{globalHook}, mp.Hooks())
+
+	result := mp.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+	assert.True(t, result.Value)
+	assert.Equal(t, 1, providerHook.before)
+	assert.Equal(t, 1, providerHook.after)
+	assert.Equal(t, 1, providerHook.finally)
+	assert.Zero(t, globalHook.before, "global hooks are executed by the SDK")
+}
+
+type countingHook struct {
+	of.UnimplementedHook
+	before, after, finally int
+}
+
+func (h *countingHook) Before(context.Context, of.HookContext, of.HookHints) (*of.EvaluationContext, error) {
+	h.before++
+	return nil, nil
+}
+
+func (h *countingHook) After(context.Context, of.HookContext, of.InterfaceEvaluationDetails, of.HookHints) error {
+	h.after++
+	return nil
+}
+
+func (h *countingHook) Finally(context.Context, of.HookContext, of.InterfaceEvaluationDetails, of.HookHints) {
+	h.finally++
+}
+
+type hookProvider struct {
+	of.FeatureProvider
+	hooks []of.Hook
+}
+
+func (p *hookProvider) Hooks() []of.Hook {
+	return p.hooks
+}
+
+type failingProvider struct {
+	of.FeatureProvider
+}
+
+func (p *failingProvider) BooleanEvaluation(_ context.Context, _ string, defaultValue bool, _ of.FlattenedContext) of.BoolResolutionDetail {
+	return of.BoolResolutionDetail{
+		Value: defaultValue,
+		ProviderResolutionDetail: of.ProviderResolutionDetail{
+			ResolutionError: of.NewGeneralResolutionError("unavailable"),
+			Reason:          of.ErrorReason,
+		},
+	}
+}
+
+type lifecycleProvider struct {
+	of.FeatureProvider
+	initErr   error
+	inits     int
+	shutdowns int
+}
+
+func (p *lifecycleProvider) Init(of.EvaluationContext) error {
+	p.inits++
+	return p.initErr
+}
+
+func (p *lifecycleProvider) Shutdown() {
+	p.shutdowns++
+}
+
+// initProvider fails to initialize the given number of times, or always if failures is negative, taking delay per attempt
+type initProvider struct {
+	of.FeatureProvider
+	failures int32
+	delay    time.Duration
+	inits    atomic.Int32
+}
+
+func (p *initProvider) Init(of.EvaluationContext) error {
+	attempt := p.inits.Add(1)
+	time.Sleep(p.delay)
+	if p.failures < 0 || attempt <= p.failures {
+		return errors.New("init failed")
+	}
+	return nil
+}
+
+func (p *initProvider) Shutdown() {}
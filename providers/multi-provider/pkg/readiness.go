@@ -0,0 +1,335 @@
+package multiprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg/strategies"
+
+	mperr "github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg/errors"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+)
+
+const (
+	defaultInitRetryInterval = 5 * time.Second
+	maxInitRetryInterval     = time.Minute
+)
+
+// ReadinessPolicy Decides when the MultiProvider counts as ready based on the states of its internal providers. Use
+// ReadyWhenAll, ReadyWhenAny, ReadyWhenPrimary or ReadyWhenCount to create one.
+type ReadinessPolicy struct {
+	// primary name of the provider that must be ready, empty if any provider counts
+	primary string
+	// minReady number of providers that must be ready, 0 if all providers must be ready
+	minReady int
+}
+
+// ReadyWhenAll The MultiProvider is ready once every internal provider is ready. This is the default.
+func ReadyWhenAll() ReadinessPolicy {
+	return ReadinessPolicy{}
+}
+
+// ReadyWhenAny The MultiProvider is ready as soon as at least one internal provider is ready
+func ReadyWhenAny() ReadinessPolicy {
+	return ReadinessPolicy{minReady: 1}
+}
+
+// ReadyWhenPrimary The MultiProvider is ready as soon as the internal provider with the given name is ready, regardless
+// of the state of the other providers
+func ReadyWhenPrimary(name string) ReadinessPolicy {
+	return ReadinessPolicy{primary: name}
+}
+
+// ReadyWhenCount The MultiProvider is ready as soon as at least n internal providers are ready
+func ReadyWhenCount(n int) ReadinessPolicy {
+	return ReadinessPolicy{minReady: n}
+}
+
+func (p ReadinessPolicy) String() string {
+	switch {
+	case p.primary != "":
+		return fmt.Sprintf("primary %s ready", p.primary)
+	case p.minReady > 0:
+		return fmt.Sprintf("%d ready", p.minReady)
+	default:
+		return "all ready"
+	}
+}
+
+// validate checks that the policy can be satisfied by the given providers
+func (p ReadinessPolicy) validate(providers ProviderMap) error {
+	switch {
+	case p.primary != "":
+		if _, exists := providers[p.primary]; !exists {
+			return fmt.Errorf("primary provider %s is not a known provider", p.primary)
+		}
+	case p.minReady < 0:
+		return errors.New("number of ready providers cannot be negative")
+	case p.minReady > len(providers):
+		return fmt.Errorf("%d ready providers required, but only %d providers are set", p.minReady, len(providers))
+	}
+	return nil
+}
+
+// satisfied reports whether the policy is satisfied by the given provider states
+func (p ReadinessPolicy) satisfied(states map[string]of.State) bool {
+	if p.primary != "" {
+		return states[p.primary] == of.ReadyState
+	}
+
+	ready := 0
+	for _, state := range states {
+		if state == of.ReadyState {
+			ready++
+		}
+	}
+	if p.minReady == 0 {
+		return ready == len(states)
+	}
+	return ready >= p.minReady
+}
+
+// initializeProviders initializes all internal providers in parallel and waits until either the readiness policy is
+// satisfied or every provider finished its first attempt. Providers failing to initialize are retried in the background
+// if retries are enabled, the errors of their first attempt are returned if the policy is not satisfied.
+func (mp *MultiProvider) initializeProviders(evalCtx of.EvaluationContext) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	mp.mu.Lock()
+	previousCancel := mp.initCancel
+	mp.initCtx = evalCtx
+	mp.initRunCtx = ctx
+	mp.initCancel = cancel
+	// the providers added so far are initialized again, so their retries are stopped along with the previous run
+	clear(mp.initCancels)
+	mp.mu.Unlock()
+	if previousCancel != nil {
+		previousCancel()
+	}
+
+	providerList := mp.current.Load().providerList
+	results := make(chan *mperr.ProviderError, len(providerList))
+	for _, p := range providerList {
+		mp.initGroup.Go(func() {
+			mp.initializeProvider(ctx, evalCtx, p, results)
+		})
+	}
+
+	errs := make([]mperr.ProviderError, 0, len(providerList))
+	for range providerList {
+		if err := <-results; err != nil {
+			errs = append(errs, *err)
+		}
+		if mp.Status() == of.ReadyState {
+			return nil
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+
+	strategies.SortByProviderOrder(providerList, errs, func(e mperr.ProviderError) string { return e.ProviderName })
+	return mperr.NewAggregateError(errs)
+}
+
+// initializeAddedProvider initializes a provider being added like initializeProviders does, as part of the current
+// initialization, so its retries are stopped by Shutdown. The provider's state must already be tracked. The error of the
+// first attempt is returned unless the readiness policy tolerates the failure, in which case the provider keeps being
+// retried in the background.
+func (mp *MultiProvider) initializeAddedProvider(p *strategies.NamedProvider) error {
+	mp.mu.Lock()
+	if mp.initRunCtx == nil {
+		mp.initRunCtx, mp.initCancel = context.WithCancel(context.Background())
+	}
+	ctx, cancel := context.WithCancel(mp.initRunCtx)
+	evalCtx := mp.initCtx
+	mp.mu.Unlock()
+
+	results := make(chan *mperr.ProviderError, 1)
+	mp.initGroup.Go(func() {
+		mp.initializeProvider(ctx, evalCtx, p, results)
+	})
+
+	var err error
+	select {
+	case providerErr := <-results:
+		if providerErr != nil {
+			err = providerErr
+		}
+	case <-ctx.Done():
+		err = &mperr.ProviderError{Err: ctx.Err(), ProviderName: p.Name}
+	}
+
+	if err != nil && (mp.config.readinessPolicy == nil || mp.Status() != of.ReadyState) {
+		cancel()
+		return err
+	}
+	if err != nil {
+		mp.logger.LogAttrs(ctx, slog.LevelWarn, "added provider failed to initialize, retrying in the background",
+			slog.String(MetadataProviderName, p.Name),
+			slog.Any("error", err),
+		)
+	}
+	mp.mu.Lock()
+	mp.initCancels[p.Name] = cancel
+	mp.mu.Unlock()
+	return nil
+}
+
+// initializeProvider initializes a single internal provider. The outcome of the first attempt is sent to results, a
+// timed out attempt is reported as failed while it continues in the background. Failed providers are retried with an
+// exponential backoff until they succeed or the context is cancelled. A provider recovering after its first attempt
+// rejoins the MultiProvider and the resulting change of the overall state is published.
+func (mp *MultiProvider) initializeProvider(ctx context.Context, evalCtx of.EvaluationContext, p *strategies.NamedProvider, results chan<- *mperr.ProviderError) {
+	stateHandle, ok := p.Provider.(of.StateHandler)
+	if !ok {
+		mp.updateProviderState(p.Name, of.ReadyState)
+		results <- nil
+		return
+	}
+
+	l := mp.logger.With(slog.String(MetadataProviderName, p.Name))
+	reported := false
+	report := func(err error) {
+		if reported {
+			return
+		}
+		reported = true
+		if err == nil {
+			results <- nil
+			return
+		}
+		results <- &mperr.ProviderError{Err: err, ProviderName: p.Name}
+	}
+	fail := func(err error) {
+		overall, changed := mp.updateProviderState(p.Name, of.ErrorState)
+		if reported && changed {
+			mp.publishInitStateChange(p, overall, fmt.Sprintf("provider %s failed to initialize: %s", p.Name, err))
+		}
+		report(err)
+	}
+
+	retryInterval := mp.initRetryInterval()
+	for attempt := 1; ; attempt++ {
+		done := make(chan error, 1)
+		go func() {
+			done <- stateHandle.Init(evalCtx)
+		}()
+
+		var err error
+		if timeout := mp.initTimeout(p.Name); timeout > 0 {
+			timer := time.NewTimer(timeout)
+			select {
+			case err = <-done:
+			case <-timer.C:
+				l.LogAttrs(ctx, slog.LevelWarn, "provider initialization timed out", slog.Duration("timeout", timeout))
+				fail(fmt.Errorf("initialization timed out after %s", timeout))
+				err = waitForInit(ctx, done)
+			case <-ctx.Done():
+			}
+			timer.Stop()
+		} else {
+			err = waitForInit(ctx, done)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err == nil {
+			overall, changed := mp.updateProviderState(p.Name, of.ReadyState)
+			if reported {
+				l.LogAttrs(ctx, slog.LevelInfo, "provider recovered and rejoined", slog.Int("attempt", attempt))
+				if changed {
+					mp.publishInitStateChange(p, overall, fmt.Sprintf("provider %s recovered", p.Name))
+				}
+			}
+			report(nil)
+			return
+		}
+
+		fail(err)
+		if retryInterval <= 0 {
+			return
+		}
+		l.LogAttrs(ctx, slog.LevelWarn, "provider failed to initialize, retrying",
+			slog.Int("attempt", attempt),
+			slog.Duration("retry-in", retryInterval),
+			slog.Any("error", err),
+		)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+		retryInterval = min(retryInterval*2, max(maxInitRetryInterval, mp.config.initRetryInterval))
+	}
+}
+
+// waitForInit waits for a running initialization to finish, unless the context is cancelled first
+func waitForInit(ctx context.Context, done <-chan error) error {
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// initTimeout the time the provider has to initialize, 0 if there is no timeout
+func (mp *MultiProvider) initTimeout(name string) time.Duration {
+	if timeout, ok := mp.config.providerInitTimeouts[name]; ok {
+		return timeout
+	}
+	return mp.config.initTimeout
+}
+
+// initRetryInterval the delay before the first retry of a failed initialization, 0 if retries are disabled
+func (mp *MultiProvider) initRetryInterval() time.Duration {
+	switch {
+	case mp.config.initRetryInterval < 0:
+		return 0
+	case mp.config.initRetryInterval > 0:
+		return mp.config.initRetryInterval
+	case mp.config.readinessPolicy != nil:
+		return defaultInitRetryInterval
+	default:
+		return 0
+	}
+}
+
+// stopInitialization stops retrying failed initializations and waits for the retry workers to return
+func (mp *MultiProvider) stopInitialization() {
+	mp.mu.Lock()
+	cancel := mp.initCancel
+	mp.initCancel = nil
+	mp.initRunCtx = nil
+	clear(mp.initCancels)
+	mp.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	mp.initGroup.Wait()
+}
+
+// publishInitStateChange publishes the overall state after it was changed by a provider initializing in the background
+func (mp *MultiProvider) publishInitStateChange(p *strategies.NamedProvider, overall of.State, message string) {
+	eventType, known := stateToEventType[overall]
+	if !known {
+		return
+	}
+	mp.publishEvent(of.Event{
+		ProviderName: mp.Metadata().Name,
+		EventType:    eventType,
+		ProviderEventDetails: of.ProviderEventDetails{
+			Message: message,
+			EventMetadata: map[string]any{
+				MetadataProviderName: p.Name,
+				MetadataProviderType: p.Provider.Metadata().Name,
+			},
+		},
+	})
+}
@@ -0,0 +1,274 @@
+package strategies
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+)
+
+const (
+	// MetadataSkippedProviders Flag metadata key listing the providers skipped because their circuit breaker is open
+	MetadataSkippedProviders = "multiprovider-skipped-providers"
+
+	CircuitClosed   CircuitState = "closed"
+	CircuitOpen     CircuitState = "open"
+	CircuitHalfOpen CircuitState = "half-open"
+
+	defaultCircuitWindowSize    = 20
+	defaultCircuitMinimumCalls  = 10
+	defaultCircuitFailureRate   = 0.5
+	defaultCircuitOpenDuration  = 30 * time.Second
+	defaultCircuitHalfOpenCalls = 1
+)
+
+// ErrCircuitOpen Reported for providers skipped because their circuit breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+type (
+	// CircuitState The state of a CircuitBreaker
+	CircuitState string
+
+	// CircuitBreakerConfig Configuration of a CircuitBreaker. Zero values are replaced by their defaults.
+	CircuitBreakerConfig struct {
+		// WindowSize Number of most recent calls used to calculate the failure rate. Defaults to 20
+		WindowSize int
+		// MinimumCalls Number of calls that must have been recorded before the circuit can open. Defaults to 10, but
+		// never more than WindowSize
+		MinimumCalls int
+		// FailureRateThreshold Failure rate within the window, between 0 and 1, at which the circuit opens. Defaults
+		// to 0.5
+		FailureRateThreshold float64
+		// SlowCallThreshold Calls taking at least this long count as failures, even if they succeeded. Zero disables
+		// the latency check
+		SlowCallThreshold time.Duration
+		// OpenDuration How long the circuit stays open before trial calls are permitted. Defaults to 30 seconds
+		OpenDuration time.Duration
+		// HalfOpenCalls Number of successful trial calls required to close the circuit again. Defaults to 1
+		HalfOpenCalls int
+	}
+
+	// CircuitStateChangeHandler Callback invoked whenever a CircuitBreaker changes its state
+	CircuitStateChangeHandler func(from CircuitState, to CircuitState)
+
+	// CircuitBreaker Tracks the health of a single provider. While closed all calls are permitted and their outcomes are
+	// recorded in a sliding window. Once the failure rate within the window reaches the threshold the circuit opens and
+	// the provider is skipped. After the open duration has passed the circuit becomes half-open and permits a limited
+	// number of trial calls: if they succeed the circuit closes, a single failure opens it again.
+	//
+	// Resolution errors caused by the provider itself (GENERAL, PROVIDER_NOT_READY & PROVIDER_FATAL) and slow calls
+	// count as failures, while errors caused by the flag or the evaluation context, such as FLAG_NOT_FOUND, do not.
+	CircuitBreaker struct {
+		config   CircuitBreakerConfig
+		now      func() time.Time
+		mu       sync.Mutex
+		state    CircuitState
+		outcomes []bool
+		next     int
+		count    int
+		failures int
+		openedAt time.Time
+		// trials & successes track the calls permitted while half-open
+		trials       int
+		trialStarted time.Time
+		successes    int
+		handlers     []CircuitStateChangeHandler
+	}
+)
+
+// NewCircuitBreaker Creates a new closed CircuitBreaker using the given configuration
+func NewCircuitBreaker(config CircuitBreakerConfig) (*CircuitBreaker, error) {
+	switch {
+	case config.WindowSize < 0:
+		return nil, errors.New("circuit breaker window size cannot be negative")
+	case config.MinimumCalls < 0:
+		return nil, errors.New("circuit breaker minimum calls cannot be negative")
+	case config.FailureRateThreshold < 0 || config.FailureRateThreshold > 1:
+		return nil, errors.New("circuit breaker failure rate threshold must be between 0 and 1")
+	case config.SlowCallThreshold < 0:
+		return nil, errors.New("circuit breaker slow call threshold cannot be negative")
+	case config.OpenDuration < 0:
+		return nil, errors.New("circuit breaker open duration cannot be negative")
+	case config.HalfOpenCalls < 0:
+		return nil, errors.New("circuit breaker half-open calls cannot be negative")
+	}
+
+	if config.WindowSize == 0 {
+		config.WindowSize = defaultCircuitWindowSize
+	}
+	if config.MinimumCalls == 0 {
+		config.MinimumCalls = defaultCircuitMinimumCalls
+	}
+	config.MinimumCalls = min(config.MinimumCalls, config.WindowSize)
+	if config.FailureRateThreshold == 0 {
+		config.FailureRateThreshold = defaultCircuitFailureRate
+	}
+	if config.OpenDuration == 0 {
+		config.OpenDuration = defaultCircuitOpenDuration
+	}
+	if config.HalfOpenCalls == 0 {
+		config.HalfOpenCalls = defaultCircuitHalfOpenCalls
+	}
+
+	return &CircuitBreaker{
+		config:   config,
+		now:      time.Now,
+		state:    CircuitClosed,
+		outcomes: make([]bool, config.WindowSize),
+	}, nil
+}
+
+// State Returns the current state of the circuit
+func (cb *CircuitBreaker) State() CircuitState {
+	if cb == nil {
+		return CircuitClosed
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return cb.state
+}
+
+// OnStateChange Registers a handler invoked after every state change. Handlers are called synchronously by the
+// evaluation causing the change, so they should return quickly.
+func (cb *CircuitBreaker) OnStateChange(handler CircuitStateChangeHandler) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.handlers = append(cb.handlers, handler)
+}
+
+// allow Reports whether a call to the provider is permitted. A nil CircuitBreaker permits every call.
+func (cb *CircuitBreaker) allow() bool {
+	if cb == nil {
+		return true
+	}
+	cb.mu.Lock()
+	now := cb.now()
+	switch cb.state {
+	case CircuitOpen:
+		if now.Sub(cb.openedAt) < cb.config.OpenDuration {
+			cb.mu.Unlock()
+			return false
+		}
+		from, handlers := cb.transition(CircuitHalfOpen)
+		cb.trials = 1
+		cb.trialStarted = now
+		cb.mu.Unlock()
+		notifyCircuitStateChange(handlers, from, CircuitHalfOpen)
+		return true
+	case CircuitHalfOpen:
+		// trial calls that never complete must not keep the circuit half-open forever
+		if cb.trials > 0 && now.Sub(cb.trialStarted) >= cb.config.OpenDuration {
+			cb.trials = 0
+		}
+		if cb.trials+cb.successes >= cb.config.HalfOpenCalls {
+			cb.mu.Unlock()
+			return false
+		}
+		cb.trials++
+		cb.trialStarted = now
+		cb.mu.Unlock()
+		return true
+	default:
+		cb.mu.Unlock()
+		return true
+	}
+}
+
+// record Records the outcome of a permitted call
+func (cb *CircuitBreaker) record(latency time.Duration, failed bool) {
+	if cb == nil {
+		return
+	}
+	if cb.config.SlowCallThreshold > 0 && latency >= cb.config.SlowCallThreshold {
+		failed = true
+	}
+
+	cb.mu.Lock()
+	var to CircuitState
+	switch cb.state {
+	case CircuitClosed:
+		if cb.count == len(cb.outcomes) && cb.outcomes[cb.next] {
+			cb.failures--
+		}
+		cb.outcomes[cb.next] = failed
+		cb.next = (cb.next + 1) % len(cb.outcomes)
+		cb.count = min(cb.count+1, len(cb.outcomes))
+		if failed {
+			cb.failures++
+		}
+		if cb.count >= cb.config.MinimumCalls && float64(cb.failures)/float64(cb.count) >= cb.config.FailureRateThreshold {
+			to = CircuitOpen
+		}
+	case CircuitHalfOpen:
+		cb.trials = max(cb.trials-1, 0)
+		if failed {
+			to = CircuitOpen
+		} else if cb.successes++; cb.successes >= cb.config.HalfOpenCalls {
+			to = CircuitClosed
+		}
+	default:
+		// calls permitted before the circuit opened do not affect it anymore
+	}
+
+	if to == "" {
+		cb.mu.Unlock()
+		return
+	}
+	from, handlers := cb.transition(to)
+	cb.mu.Unlock()
+	notifyCircuitStateChange(handlers, from, to)
+}
+
+// release Releases a permitted call whose outcome says nothing about the health of the provider, e.g. because the
+// evaluation was cancelled by the caller
+func (cb *CircuitBreaker) release() {
+	if cb == nil {
+		return
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if cb.state == CircuitHalfOpen {
+		cb.trials = max(cb.trials-1, 0)
+	}
+}
+
+// transition Moves the circuit into the given state and resets the counters. The lock must be held by the caller.
+func (cb *CircuitBreaker) transition(to CircuitState) (CircuitState, []CircuitStateChangeHandler) {
+	from := cb.state
+	cb.state = to
+	cb.next, cb.count, cb.failures = 0, 0, 0
+	cb.trials, cb.successes = 0, 0
+	if to == CircuitOpen {
+		cb.openedAt = cb.now()
+	}
+	return from, cb.handlers
+}
+
+func notifyCircuitStateChange(handlers []CircuitStateChangeHandler, from CircuitState, to CircuitState) {
+	for _, h := range handlers {
+		h(from, to)
+	}
+}
+
+// isCircuitFailure Reports whether the resolution detail indicates a failure of the provider itself
+func isCircuitFailure(detail of.ProviderResolutionDetail) bool {
+	switch detail.ResolutionDetail().ErrorCode {
+	case of.GeneralCode, of.ProviderNotReadyCode, of.ProviderFatalCode:
+		return true
+	default:
+		return false
+	}
+}
+
+// recordCircuitResult Records the outcome of a call with the circuit breaker of the provider. Failures caused by the
+// cancellation of the context, rather than by the provider, are released instead of being recorded.
+func recordCircuitResult(ctx context.Context, p *NamedProvider, latency time.Duration, detail of.ProviderResolutionDetail) {
+	failed := isCircuitFailure(detail)
+	if failed && errors.Is(ctx.Err(), context.Canceled) {
+		p.CircuitBreaker.release()
+		return
+	}
+	p.CircuitBreaker.record(latency, failed)
+}
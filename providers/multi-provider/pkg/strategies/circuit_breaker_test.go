@@ -0,0 +1,269 @@
+package strategies
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type circuitTransition struct {
+	from, to CircuitState
+}
+
+// newTestCircuitBreaker Creates a circuit breaker using a controllable clock and records its transitions
+func newTestCircuitBreaker(t *testing.T, config CircuitBreakerConfig) (*CircuitBreaker, *time.Time, *[]circuitTransition) {
+	t.Helper()
+	cb, err := NewCircuitBreaker(config)
+	require.NoError(t, err)
+	now := time.Now()
+	cb.now = func() time.Time { return now }
+	transitions := make([]circuitTransition, 0)
+	cb.OnStateChange(func(from CircuitState, to CircuitState) {
+		transitions = append(transitions, circuitTransition{from, to})
+	})
+	return cb, &now, &transitions
+}
+
+func Test_NewCircuitBreaker(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		cb, err := NewCircuitBreaker(CircuitBreakerConfig{})
+		require.NoError(t, err)
+		assert.Equal(t, CircuitClosed, cb.State())
+		assert.Equal(t, CircuitBreakerConfig{
+			WindowSize:           defaultCircuitWindowSize,
+			MinimumCalls:         defaultCircuitMinimumCalls,
+			FailureRateThreshold: defaultCircuitFailureRate,
+			OpenDuration:         defaultCircuitOpenDuration,
+			HalfOpenCalls:        defaultCircuitHalfOpenCalls,
+		}, cb.config)
+	})
+
+	t.Run("minimum calls never exceed the window", func(t *testing.T) {
+		cb, err := NewCircuitBreaker(CircuitBreakerConfig{WindowSize: 4})
+		require.NoError(t, err)
+		assert.Equal(t, 4, cb.config.MinimumCalls)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		for name, config := range map[string]CircuitBreakerConfig{
+			"window":    {WindowSize: -1},
+			"threshold": {FailureRateThreshold: 1.5},
+			"duration":  {OpenDuration: -time.Second},
+			"half-open": {HalfOpenCalls: -1},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := NewCircuitBreaker(config)
+				assert.Error(t, err)
+			})
+		}
+	})
+
+	t.Run("nil circuit breaker permits all calls", func(t *testing.T) {
+		var cb *CircuitBreaker
+		assert.True(t, cb.allow())
+		cb.record(time.Hour, true)
+		assert.Equal(t, CircuitClosed, cb.State())
+	})
+}
+
+func Test_CircuitBreaker_Transitions(t *testing.T) {
+	config := CircuitBreakerConfig{
+		WindowSize:           4,
+		MinimumCalls:         4,
+		FailureRateThreshold: 0.5,
+		OpenDuration:         time.Minute,
+	}
+
+	t.Run("opens once the failure rate reaches the threshold", func(t *testing.T) {
+		cb, _, transitions := newTestCircuitBreaker(t, config)
+		cb.record(time.Millisecond, true)
+		cb.record(time.Millisecond, false)
+		cb.record(time.Millisecond, false)
+		assert.Equal(t, CircuitClosed, cb.State(), "minimum calls not reached yet")
+		cb.record(time.Millisecond, true)
+		assert.Equal(t, CircuitOpen, cb.State())
+		assert.False(t, cb.allow())
+		assert.Equal(t, []circuitTransition{{CircuitClosed, CircuitOpen}}, *transitions)
+	})
+
+	t.Run("old outcomes leave the window", func(t *testing.T) {
+		cb, _, _ := newTestCircuitBreaker(t, config)
+		cb.record(time.Millisecond, true)
+		for range 4 {
+			cb.record(time.Millisecond, false)
+		}
+		cb.record(time.Millisecond, true)
+		assert.Equal(t, CircuitClosed, cb.State())
+		cb.record(time.Millisecond, true)
+		assert.Equal(t, CircuitOpen, cb.State())
+	})
+
+	t.Run("slow calls count as failures", func(t *testing.T) {
+		slowConfig := config
+		slowConfig.SlowCallThreshold = 100 * time.Millisecond
+		cb, _, _ := newTestCircuitBreaker(t, slowConfig)
+		cb.record(time.Second, false)
+		cb.record(time.Second, false)
+		cb.record(time.Millisecond, false)
+		cb.record(time.Millisecond, false)
+		assert.Equal(t, CircuitOpen, cb.State())
+	})
+
+	t.Run("half-open trial success closes the circuit", func(t *testing.T) {
+		cb, now, transitions := newTestCircuitBreaker(t, config)
+		for range 4 {
+			cb.record(time.Millisecond, true)
+		}
+		*now = now.Add(time.Minute)
+		assert.True(t, cb.allow())
+		assert.Equal(t, CircuitHalfOpen, cb.State())
+		assert.False(t, cb.allow(), "only a single trial call is permitted")
+		cb.record(time.Millisecond, false)
+		assert.Equal(t, CircuitClosed, cb.State())
+		assert.Equal(t, []circuitTransition{
+			{CircuitClosed, CircuitOpen},
+			{CircuitOpen, CircuitHalfOpen},
+			{CircuitHalfOpen, CircuitClosed},
+		}, *transitions)
+	})
+
+	t.Run("half-open trial failure opens the circuit again", func(t *testing.T) {
+		cb, now, _ := newTestCircuitBreaker(t, config)
+		for range 4 {
+			cb.record(time.Millisecond, true)
+		}
+		*now = now.Add(time.Minute)
+		assert.True(t, cb.allow())
+		cb.record(time.Millisecond, true)
+		assert.Equal(t, CircuitOpen, cb.State())
+		assert.False(t, cb.allow())
+	})
+
+	t.Run("released trial call permits another one", func(t *testing.T) {
+		cb, now, _ := newTestCircuitBreaker(t, config)
+		for range 4 {
+			cb.record(time.Millisecond, true)
+		}
+		*now = now.Add(time.Minute)
+		assert.True(t, cb.allow())
+		cb.release()
+		assert.True(t, cb.allow())
+	})
+}
+
+func Test_CircuitBreaker_Strategies(t *testing.T) {
+	openBreaker := func(t *testing.T) *CircuitBreaker {
+		cb, err := NewCircuitBreaker(CircuitBreakerConfig{WindowSize: 1, OpenDuration: time.Minute})
+		require.NoError(t, err)
+		cb.record(time.Millisecond, true)
+		require.Equal(t, CircuitOpen, cb.State())
+		return cb
+	}
+
+	t.Run("first match skips open provider", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 2)
+		providers[0].CircuitBreaker = openBreaker(t)
+		mocks[providers[0].Name].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mocks[providers[1].Name].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(of.BoolResolutionDetail{Value: true})
+
+		result := NewFirstMatchStrategy(providers).BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+		assert.True(t, result.Value)
+		assert.Equal(t, "1", result.FlagMetadata[MetadataSuccessfulProviderName])
+		assert.Equal(t, "0", result.FlagMetadata[MetadataSkippedProviders])
+	})
+
+	t.Run("first match reports an error if the flag might exist in a skipped provider", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 2)
+		providers[0].CircuitBreaker = openBreaker(t)
+		mocks[providers[1].Name].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(of.BoolResolutionDetail{ProviderResolutionDetail: of.ProviderResolutionDetail{
+				ResolutionError: of.NewFlagNotFoundResolutionError("not found"),
+			}})
+
+		result := NewFirstMatchStrategy(providers).BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+		assert.Equal(t, of.ErrorReason, result.Reason)
+		assert.Equal(t, of.GeneralCode, result.ResolutionDetail().ErrorCode)
+		assert.Contains(t, result.ResolutionDetail().ErrorMessage, ErrCircuitOpen.Error())
+		assert.Equal(t, "0", result.FlagMetadata[MetadataSkippedProviders])
+	})
+
+	t.Run("first match records failures", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 1)
+		cb, err := NewCircuitBreaker(CircuitBreakerConfig{WindowSize: 2, OpenDuration: time.Minute})
+		require.NoError(t, err)
+		providers[0].CircuitBreaker = cb
+		mocks[providers[0].Name].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(of.BoolResolutionDetail{ProviderResolutionDetail: of.ProviderResolutionDetail{
+				ResolutionError: of.NewGeneralResolutionError("unavailable"),
+			}}).Times(2)
+
+		strategy := NewFirstMatchStrategy(providers)
+		for range 3 {
+			strategy.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+		}
+		assert.Equal(t, CircuitOpen, cb.State())
+	})
+
+	t.Run("flag not found is not a failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 1)
+		cb, err := NewCircuitBreaker(CircuitBreakerConfig{WindowSize: 1})
+		require.NoError(t, err)
+		providers[0].CircuitBreaker = cb
+		mocks[providers[0].Name].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(of.BoolResolutionDetail{ProviderResolutionDetail: of.ProviderResolutionDetail{
+				ResolutionError: of.NewFlagNotFoundResolutionError("not found"),
+			}})
+
+		NewFirstMatchStrategy(providers).BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+		assert.Equal(t, CircuitClosed, cb.State())
+	})
+
+	t.Run("first success skips open provider", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 2)
+		providers[0].CircuitBreaker = openBreaker(t)
+		mocks[providers[0].Name].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mocks[providers[1].Name].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(of.BoolResolutionDetail{Value: true})
+
+		result := NewFirstSuccessStrategy(providers, time.Second).BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+		assert.True(t, result.Value)
+		assert.Equal(t, "1", result.FlagMetadata[MetadataSuccessfulProviderName])
+		assert.Equal(t, "0", result.FlagMetadata[MetadataSkippedProviders])
+	})
+
+	t.Run("first success with all providers skipped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, _ := createMockProviders(ctrl, 2)
+		providers[0].CircuitBreaker = openBreaker(t)
+		providers[1].CircuitBreaker = openBreaker(t)
+
+		start := time.Now()
+		result := NewFirstSuccessStrategy(providers, time.Second).BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+		assert.Less(t, time.Since(start), time.Second, "must not wait for the timeout")
+		assert.Equal(t, of.ErrorReason, result.Reason)
+		assert.Contains(t, result.ResolutionDetail().ErrorMessage, ErrCircuitOpen.Error())
+		assert.Equal(t, "0, 1", result.FlagMetadata[MetadataSkippedProviders])
+	})
+
+	t.Run("cancelled evaluations are not recorded", func(t *testing.T) {
+		cb, err := NewCircuitBreaker(CircuitBreakerConfig{WindowSize: 1})
+		require.NoError(t, err)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		detail := of.ProviderResolutionDetail{ResolutionError: of.NewGeneralResolutionError(errors.New("cancelled").Error())}
+		recordCircuitResult(ctx, &NamedProvider{Name: "p", CircuitBreaker: cb}, time.Millisecond, detail)
+		assert.Equal(t, CircuitClosed, cb.State())
+	})
+}
@@ -7,7 +7,7 @@ import (
 	mperr "github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg/errors"
 	of "github.com/open-feature/go-sdk/openfeature"
 	"golang.org/x/sync/errgroup"
-	"slices"
+	"maps"
 	"strings"
 )
 
@@ -21,18 +21,37 @@ type (
 	ComparisonStrategy struct {
 		providers        []*NamedProvider
 		fallbackProvider of.FeatureProvider
+		objectComparator ObjectComparator
 	}
 
-	comparator[R bool | string | int64 | float64] func(values []R) bool
+	// ComparisonOption Function used for configuring a ComparisonStrategy
+	ComparisonOption func(*ComparisonStrategy)
+
+	comparator[R bool | string | int64 | float64 | interface{}] func(values []R) bool
 )
 
 var _ Strategy = (*ComparisonStrategy)(nil)
 
-func NewComparisonStrategy(providers []*NamedProvider, fallbackProvider of.FeatureProvider) *ComparisonStrategy {
-	return &ComparisonStrategy{
+// WithObjectComparator Sets the function used for comparing object flag values. By default JSONEqual is used.
+func WithObjectComparator(c ObjectComparator) ComparisonOption {
+	return func(s *ComparisonStrategy) {
+		s.objectComparator = c
+	}
+}
+
+func NewComparisonStrategy(providers []*NamedProvider, fallbackProvider of.FeatureProvider, opts ...ComparisonOption) *ComparisonStrategy {
+	s := &ComparisonStrategy{
 		providers:        providers,
 		fallbackProvider: fallbackProvider,
+		objectComparator: JSONEqual,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.objectComparator == nil {
+		s.objectComparator = JSONEqual
+	}
+	return s
 }
 
 func (c ComparisonStrategy) Name() EvaluationStrategy {
@@ -217,23 +236,38 @@ func comparisonResolutionError(metadata of.FlagMetadata) of.ResolutionError {
 }
 
 func (c ComparisonStrategy) ObjectEvaluation(ctx context.Context, flag string, defaultValue interface{}, evalCtx of.FlattenedContext) of.InterfaceResolutionDetail {
-	metadata := make(of.FlagMetadata)
-	metadata[MetadataStrategyUsed] = StrategyComparison
-	metadata[MetadataSuccessfulProviderName] = "none"
-	metadata[MetadataFallbackUsed] = false
+	evalFunc := func(c context.Context, p *NamedProvider) resultWrapper[of.InterfaceResolutionDetail] {
+		result := p.Provider.ObjectEvaluation(ctx, flag, defaultValue, evalCtx)
+		return resultWrapper[of.InterfaceResolutionDetail]{
+			result: &result,
+			name:   p.Name,
+			value:  result.Value,
+			detail: result.ProviderResolutionDetail,
+		}
+	}
+	compFunc := func(values []interface{}) bool {
+		for _, v := range values[1:] {
+			if !c.objectComparator(values[0], v) {
+				return false
+			}
+		}
 
+		return true
+	}
+
+	results, metadata := evaluateComparison[of.InterfaceResolutionDetail, interface{}](ctx, c.providers, evalFunc, compFunc, c.fallbackProvider, defaultValue)
 	return of.InterfaceResolutionDetail{
-		Value: defaultValue,
+		Value: results[0].result.Value,
 		ProviderResolutionDetail: of.ProviderResolutionDetail{
-			ResolutionError: of.NewGeneralResolutionError(ErrAggregationNotAllowedText),
-			Reason:          of.DefaultReason,
-			Variant:         "",
+			ResolutionError: comparisonResolutionError(metadata),
+			Reason:          comparisonResolutionReason(metadata),
+			Variant:         results[0].detail.Variant,
 			FlagMetadata:    metadata,
 		},
 	}
 }
 
-func evaluateComparison[R resultConstraint, DV bool | string | int64 | float64](ctx context.Context, providers []*NamedProvider, e evaluator[R], comp comparator[DV], fallbackProvider of.FeatureProvider, defaultVal DV) ([]resultWrapper[R], of.FlagMetadata) {
+func evaluateComparison[R resultConstraint, DV bool | string | int64 | float64 | interface{}](ctx context.Context, providers []*NamedProvider, e evaluator[R], comp comparator[DV], fallbackProvider of.FeatureProvider, defaultVal DV) ([]resultWrapper[R], of.FlagMetadata) {
 	if len(providers) == 1 {
 		result := e(ctx, providers[0])
 		metadata := setFlagMetadata(StrategyComparison, cmp.Or(result.name, providers[0].Name), make(of.FlagMetadata))
@@ -242,7 +276,7 @@ func evaluateComparison[R resultConstraint, DV bool | string | int64 | float64](
 	}
 
 	resultChan := make(chan *resultWrapper[R], len(providers))
-	notFoundChan := make(chan interface{})
+	notFoundChan := make(chan mperr.ProviderError)
 	errGrp, ctx := errgroup.WithContext(ctx)
 	for _, provider := range providers {
 		errGrp.Go(func() error {
@@ -259,13 +293,13 @@ func evaluateComparison[R resultConstraint, DV bool | string | int64 | float64](
 				if !notFound && r.detail.Error() != nil {
 					return &mperr.ProviderError{
 						ProviderName: r.name,
-						Err:          r.detail.Error(),
+						Err:          r.detail.ResolutionError,
 					}
 				}
 				if !notFound {
 					resultChan <- r
 				} else {
-					notFoundChan <- struct{}{}
+					notFoundChan <- mperr.ProviderError{ProviderName: r.name, Err: r.detail.ResolutionError}
 				}
 				return nil
 			case <-ctx.Done():
@@ -276,7 +310,7 @@ func evaluateComparison[R resultConstraint, DV bool | string | int64 | float64](
 
 	results := make([]resultWrapper[R], 0, len(providers))
 	resultValues := make([]DV, 0, len(providers))
-	notFoundCount := 0
+	notFound := make([]mperr.ProviderError, 0, len(providers))
 	for {
 		select {
 		case <-ctx.Done():
@@ -286,28 +320,42 @@ func evaluateComparison[R resultConstraint, DV bool | string | int64 | float64](
 			metadata[MetadataFallbackUsed] = false
 			metadata[MetadataIsDefault] = true
 			metadata[MetadataEvaluationError] = ctx.Err().Error()
+			// the error group cancels the context with the error of the first failing provider
+			var providerErr *mperr.ProviderError
+			if errors.As(context.Cause(ctx), &providerErr) {
+				setProviderErrors(metadata, *providerErr)
+			}
+			setProviderErrors(metadata, notFound...)
 			return []resultWrapper[R]{result}, metadata
 		case r := <-resultChan:
 			results = append(results, *r)
-			resultValues = append(resultValues, r.value.(DV))
-			if (len(results) + notFoundCount) == len(providers) {
+			if (len(results) + len(notFound)) == len(providers) {
 				goto continueComparison
 			}
-		case <-notFoundChan:
-			notFoundCount += 1
-			if notFoundCount == len(providers) {
+		case err := <-notFoundChan:
+			notFound = append(notFound, err)
+			if len(notFound) == len(providers) {
 				result := buildDefaultResult[R, DV](StrategyComparison, defaultVal, ctx.Err())
 				metadata := result.detail.FlagMetadata
 				metadata[MetadataFallbackUsed] = false
 				metadata[MetadataIsDefault] = true
+				setProviderErrors(metadata, notFound...)
 				return []resultWrapper[R]{result}, metadata
 			}
-			if (len(results) + notFoundCount) == len(providers) {
+			if (len(results) + len(notFound)) == len(providers) {
 				goto continueComparison
 			}
 		}
 	}
 continueComparison:
+	// results arrive in completion order, restore the order of the providers
+	SortByProviderOrder(providers, results, func(r resultWrapper[R]) string { return r.name })
+	for _, r := range results {
+		// a nil object value does not satisfy the type assertion, use the zero value instead
+		value, _ := r.value.(DV)
+		resultValues = append(resultValues, value)
+	}
+
 	// Evaluate Results Are Equal
 	metadata := make(of.FlagMetadata)
 	metadata[MetadataStrategyUsed] = StrategyComparison
@@ -320,15 +368,18 @@ continueComparison:
 			metadata[r.name] = r.detail.FlagMetadata
 			success = append(success, r.name)
 		}
-		// maintain stable order of metadata results
-		slices.Sort(success)
+		// results are in provider order, keeping the metadata stable
 		metadata[MetadataSuccessfulProviderName+"s"] = strings.Join(success, ", ")
 		return results, metadata
 	}
 
 	if fallbackProvider != nil {
 		fallbackResult := e(ctx, &NamedProvider{Name: "fallback", Provider: fallbackProvider})
-		metadata = fallbackResult.detail.FlagMetadata
+		// providers may return nil metadata, and must never see their own metadata modified
+		metadata = maps.Clone(fallbackResult.detail.FlagMetadata)
+		if metadata == nil {
+			metadata = make(of.FlagMetadata)
+		}
 		metadata[MetadataFallbackUsed] = true
 		metadata[MetadataIsDefault] = false
 		metadata[MetadataSuccessfulProviderName] = "fallback"
@@ -166,6 +166,35 @@ func Test_ComparisonStrategy_BooleanEvaluation(t *testing.T) {
 		assert.False(t, result.FlagMetadata[MetadataFallbackUsed].(bool))
 	})
 
+	t.Run("success metadata retains provider order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		provider1 := mocks.NewMockFeatureProvider(ctrl)
+		configureComparisonProvider(provider1, true, true, TestErrorNone)
+		provider2 := mocks.NewMockFeatureProvider(ctrl)
+		configureComparisonProvider(provider2, true, true, TestErrorNone)
+		provider3 := mocks.NewMockFeatureProvider(ctrl)
+		configureComparisonProvider(provider3, true, true, TestErrorNone)
+
+		strategy := NewComparisonStrategy([]*NamedProvider{
+			{
+				Name:     "vendor",
+				Provider: provider1,
+			},
+			{
+				Name:     "flagd",
+				Provider: provider2,
+			},
+			{
+				Name:     "local",
+				Provider: provider3,
+			},
+		}, nil)
+
+		result := strategy.BooleanEvaluation(context.Background(), TestFlag, false, of.FlattenedContext{})
+		assert.True(t, result.Value)
+		assert.Equal(t, "vendor, flagd, local", result.FlagMetadata[MetadataSuccessfulProviderName+"s"])
+	})
+
 	t.Run("multiple not found with single success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		fallback := mocks.NewMockFeatureProvider(ctrl)
@@ -288,6 +317,42 @@ func Test_ComparisonStrategy_BooleanEvaluation(t *testing.T) {
 		assert.True(t, result.FlagMetadata[MetadataFallbackUsed].(bool))
 	})
 
+	t.Run("fallback metadata is neither required nor modified", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		fallbackMetadata := of.FlagMetadata{"owner": "fallback"}
+		fallback := mocks.NewMockFeatureProvider(ctrl)
+		fallback.EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.BoolResolutionDetail{
+			Value:                    true,
+			ProviderResolutionDetail: of.ProviderResolutionDetail{FlagMetadata: fallbackMetadata},
+		})
+		nilFallback := mocks.NewMockFeatureProvider(ctrl)
+		nilFallback.EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.BoolResolutionDetail{
+			Value: true,
+		})
+		provider1 := mocks.NewMockFeatureProvider(ctrl)
+		provider1.EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.BoolResolutionDetail{
+			Value: false,
+		}).AnyTimes()
+		provider2 := mocks.NewMockFeatureProvider(ctrl)
+		provider2.EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.BoolResolutionDetail{
+			Value: true,
+		}).AnyTimes()
+		providers := []*NamedProvider{
+			{Name: "test-provider1", Provider: provider1},
+			{Name: "test-provider2", Provider: provider2},
+		}
+
+		result := NewComparisonStrategy(providers, fallback).BooleanEvaluation(context.Background(), TestFlag, false, of.FlattenedContext{})
+		assert.True(t, result.Value)
+		assert.Equal(t, "fallback", result.FlagMetadata["owner"])
+		assert.True(t, result.FlagMetadata[MetadataFallbackUsed].(bool))
+		assert.Equal(t, of.FlagMetadata{"owner": "fallback"}, fallbackMetadata)
+
+		result = NewComparisonStrategy(providers, nilFallback).BooleanEvaluation(context.Background(), TestFlag, false, of.FlattenedContext{})
+		assert.True(t, result.Value)
+		assert.True(t, result.FlagMetadata[MetadataFallbackUsed].(bool))
+	})
+
 	t.Run("comparison failure with not found", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		fallback := mocks.NewMockFeatureProvider(ctrl)
@@ -1380,36 +1445,119 @@ func Test_ComparisonStrategy_FloatEvaluation(t *testing.T) {
 	})
 }
 
-func Test_ComparisonStrategy_ObjectEvaluation_AlwaysReturnsDefault(t *testing.T) {
-	successVal := struct{ Name string }{Name: "test"}
-	defaultVal := struct{}{}
-	ctrl := gomock.NewController(t)
-	fallback := mocks.NewMockFeatureProvider(ctrl)
-	fallback.EXPECT().FloatEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
-	provider1 := mocks.NewMockFeatureProvider(ctrl)
-	configureComparisonProvider(provider1, successVal, true, TestErrorNone)
-	provider2 := mocks.NewMockFeatureProvider(ctrl)
-	configureComparisonProvider(provider2, defaultVal, true, TestErrorError)
-
-	strategy := NewComparisonStrategy([]*NamedProvider{
-		{
-			Name:     "test-provider1",
-			Provider: provider1,
-		},
-		{
-			Name:     "test-provider2",
-			Provider: provider2,
-		},
-	}, fallback)
-
-	result := strategy.ObjectEvaluation(context.Background(), TestFlag, defaultVal, of.FlattenedContext{})
-	assert.Equal(t, defaultVal, result.Value)
-	assert.Equal(t, of.DefaultReason, result.Reason)
-	assert.Equal(t, of.NewGeneralResolutionError(ErrAggregationNotAllowedText), result.ResolutionError)
-	assert.Contains(t, result.FlagMetadata, MetadataStrategyUsed)
-	assert.Equal(t, StrategyComparison, result.FlagMetadata[MetadataStrategyUsed])
-	assert.NotContains(t, result.FlagMetadata, MetadataSuccessfulProviderName+"s")
-	assert.Contains(t, result.FlagMetadata, MetadataSuccessfulProviderName)
-	assert.Equal(t, "none", result.FlagMetadata[MetadataSuccessfulProviderName])
-	assert.False(t, result.FlagMetadata[MetadataFallbackUsed].(bool))
+func Test_ComparisonStrategy_ObjectEvaluation(t *testing.T) {
+	defaultVal := map[string]any{}
+	t.Run("structurally equal values agree", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		fallback := mocks.NewMockFeatureProvider(ctrl)
+		fallback.EXPECT().ObjectEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		provider1 := mocks.NewMockFeatureProvider(ctrl)
+		configureComparisonProvider(provider1, map[string]any{"limit": 10, "tags": []string{"a", "b"}}, true, TestErrorNone)
+		provider2 := mocks.NewMockFeatureProvider(ctrl)
+		configureComparisonProvider(provider2, map[string]any{"tags": []any{"a", "b"}, "limit": float64(10)}, true, TestErrorNone)
+
+		strategy := NewComparisonStrategy([]*NamedProvider{
+			{
+				Name:     "test-provider1",
+				Provider: provider1,
+			},
+			{
+				Name:     "test-provider2",
+				Provider: provider2,
+			},
+		}, fallback)
+
+		result := strategy.ObjectEvaluation(context.Background(), TestFlag, defaultVal, of.FlattenedContext{})
+		assert.Equal(t, map[string]any{"limit": 10, "tags": []string{"a", "b"}}, result.Value)
+		assert.Equal(t, ReasonAggregated, result.Reason)
+		assert.NoError(t, result.Error())
+		assert.Equal(t, StrategyComparison, result.FlagMetadata[MetadataStrategyUsed])
+		assert.Equal(t, "test-provider1, test-provider2", result.FlagMetadata[MetadataSuccessfulProviderName+"s"])
+		assert.False(t, result.FlagMetadata[MetadataFallbackUsed].(bool))
+	})
+
+	t.Run("mismatch uses fallback", func(t *testing.T) {
+		fallbackVal := map[string]any{"limit": 5}
+		ctrl := gomock.NewController(t)
+		fallback := mocks.NewMockFeatureProvider(ctrl)
+		configureComparisonProvider(fallback, fallbackVal, true, TestErrorNone)
+		provider1 := mocks.NewMockFeatureProvider(ctrl)
+		configureComparisonProvider(provider1, map[string]any{"limit": 10}, true, TestErrorNone)
+		provider2 := mocks.NewMockFeatureProvider(ctrl)
+		configureComparisonProvider(provider2, map[string]any{"limit": 10.5}, true, TestErrorNone)
+
+		strategy := NewComparisonStrategy([]*NamedProvider{
+			{
+				Name:     "test-provider1",
+				Provider: provider1,
+			},
+			{
+				Name:     "test-provider2",
+				Provider: provider2,
+			},
+		}, fallback)
+
+		result := strategy.ObjectEvaluation(context.Background(), TestFlag, defaultVal, of.FlattenedContext{})
+		assert.Equal(t, fallbackVal, result.Value)
+		assert.Equal(t, ReasonAggregatedFallback, result.Reason)
+		assert.Equal(t, "fallback", result.FlagMetadata[MetadataSuccessfulProviderName])
+		assert.True(t, result.FlagMetadata[MetadataFallbackUsed].(bool))
+	})
+
+	t.Run("provider error returns default", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		fallback := mocks.NewMockFeatureProvider(ctrl)
+		fallback.EXPECT().ObjectEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		provider1 := mocks.NewMockFeatureProvider(ctrl)
+		configureComparisonProvider(provider1, map[string]any{"limit": 10}, true, TestErrorNone)
+		provider2 := mocks.NewMockFeatureProvider(ctrl)
+		configureComparisonProvider(provider2, defaultVal, true, TestErrorError)
+
+		strategy := NewComparisonStrategy([]*NamedProvider{
+			{
+				Name:     "test-provider1",
+				Provider: provider1,
+			},
+			{
+				Name:     "test-provider2",
+				Provider: provider2,
+			},
+		}, fallback)
+
+		result := strategy.ObjectEvaluation(context.Background(), TestFlag, defaultVal, of.FlattenedContext{})
+		assert.Equal(t, defaultVal, result.Value)
+		assert.Equal(t, of.DefaultReason, result.Reason)
+		assert.Error(t, result.Error())
+		assert.Equal(t, "none", result.FlagMetadata[MetadataSuccessfulProviderName])
+		assert.False(t, result.FlagMetadata[MetadataFallbackUsed].(bool))
+	})
+
+	t.Run("custom comparator", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		fallback := mocks.NewMockFeatureProvider(ctrl)
+		fallback.EXPECT().ObjectEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		provider1 := mocks.NewMockFeatureProvider(ctrl)
+		configureComparisonProvider(provider1, map[string]any{"limit": 10, "updated": "monday"}, true, TestErrorNone)
+		provider2 := mocks.NewMockFeatureProvider(ctrl)
+		configureComparisonProvider(provider2, map[string]any{"limit": 10, "updated": "tuesday"}, true, TestErrorNone)
+
+		ignoreUpdated := func(a, b any) bool {
+			return JSONEqual(a.(map[string]any)["limit"], b.(map[string]any)["limit"])
+		}
+		strategy := NewComparisonStrategy([]*NamedProvider{
+			{
+				Name:     "test-provider1",
+				Provider: provider1,
+			},
+			{
+				Name:     "test-provider2",
+				Provider: provider2,
+			},
+		}, fallback, WithObjectComparator(ignoreUpdated))
+
+		result := strategy.ObjectEvaluation(context.Background(), TestFlag, defaultVal, of.FlattenedContext{})
+		assert.Equal(t, map[string]any{"limit": 10, "updated": "monday"}, result.Value)
+		assert.Equal(t, ReasonAggregated, result.Reason)
+		assert.False(t, result.FlagMetadata[MetadataFallbackUsed].(bool))
+	})
 }
@@ -0,0 +1,107 @@
+package strategies
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+)
+
+// ContextRoutingStrategy Routes each evaluation to exactly one provider based on the value of an attribute of the
+// evaluation context, e.g. `tenant` or `region`. Values without a route are sent to the default provider.
+type ContextRoutingStrategy struct {
+	routingStrategy
+	attribute string
+	routes    map[string]string
+}
+
+var _ Strategy = (*ContextRoutingStrategy)(nil)
+
+// NewContextRoutingStrategy Creates a new ContextRoutingStrategy instance. Routes map the values of the attribute to
+// the names of the providers. Evaluations with a missing or unknown attribute value, or a value of a type other than a
+// string, bool or number, are sent to the default provider. The default provider may be empty, in which case these
+// evaluations resolve to TARGETING_KEY_MISSING if the attribute is missing and is the targeting key, or INVALID_CONTEXT
+// otherwise.
+func NewContextRoutingStrategy(providers []*NamedProvider, attribute string, routes map[string]string, defaultProvider string) (*ContextRoutingStrategy, error) {
+	if attribute == "" {
+		return nil, errors.New("routing attribute cannot be the empty string")
+	}
+
+	byName := make(map[string]*NamedProvider, len(providers))
+	for _, p := range providers {
+		byName[p.Name] = p
+	}
+
+	for _, value := range slices.Sorted(maps.Keys(routes)) {
+		provider := routes[value]
+		if _, ok := byName[provider]; !ok {
+			return nil, fmt.Errorf("route %s=%s references unknown provider %s", attribute, value, provider)
+		}
+	}
+
+	var fallback *NamedProvider
+	if defaultProvider != "" {
+		p, ok := byName[defaultProvider]
+		if !ok {
+			return nil, fmt.Errorf("default provider %s is unknown", defaultProvider)
+		}
+		fallback = p
+	}
+
+	s := &ContextRoutingStrategy{attribute: attribute, routes: maps.Clone(routes)}
+	s.routingStrategy = routingStrategy{
+		name: StrategyContextRouting,
+		route: func(_ string, evalCtx of.FlattenedContext) (*NamedProvider, string, of.ResolutionError) {
+			value, present := evalCtx[s.attribute]
+			if !present {
+				if fallback != nil {
+					return fallback, "default", of.ResolutionError{}
+				}
+				msg := fmt.Sprintf("routing attribute %s is missing from the evaluation context", s.attribute)
+				if s.attribute == of.TargetingKey {
+					return nil, "", of.NewTargetingKeyMissingResolutionError(msg)
+				}
+				return nil, "", of.NewInvalidContextResolutionError(msg)
+			}
+
+			key, ok := routingValue(value)
+			if !ok {
+				if fallback != nil {
+					return fallback, "default", of.ResolutionError{}
+				}
+				return nil, "", of.NewInvalidContextResolutionError(fmt.Sprintf("routing attribute %s has unsupported type %T", s.attribute, value))
+			}
+			rule := s.attribute + "=" + key
+			if provider, ok := s.routes[key]; ok {
+				return byName[provider], "context:" + rule, of.ResolutionError{}
+			}
+			if fallback != nil {
+				return fallback, "default", of.ResolutionError{}
+			}
+			return nil, "", of.NewInvalidContextResolutionError(fmt.Sprintf("no provider configured for %s", rule))
+		},
+	}
+
+	return s, nil
+}
+
+// routingValue Converts a scalar attribute value into the string used for matching routes
+func routingValue(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case int:
+		return strconv.Itoa(v), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,118 @@
+package strategies
+
+import (
+	"context"
+	"testing"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_ContextRoutingStrategy(t *testing.T) {
+	routes := map[string]string{
+		"acme":   "1",
+		"globex": "2",
+		"42":     "2",
+	}
+
+	t.Run("routes by attribute value", func(t *testing.T) {
+		cases := map[string]struct {
+			evalCtx  of.FlattenedContext
+			provider string
+			rule     string
+		}{
+			"mapped value":   {evalCtx: of.FlattenedContext{"tenant": "acme"}, provider: "1", rule: "context:tenant=acme"},
+			"numeric value":  {evalCtx: of.FlattenedContext{"tenant": int64(42)}, provider: "2", rule: "context:tenant=42"},
+			"unmapped value": {evalCtx: of.FlattenedContext{"tenant": "initech"}, provider: "0", rule: "default"},
+			"missing value":  {evalCtx: of.FlattenedContext{}, provider: "0", rule: "default"},
+		}
+		for name, tc := range cases {
+			t.Run(name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				providers, mocks := createMockProviders(ctrl, 3)
+				mocks[tc.provider].EXPECT().StringEvaluation(gomock.Any(), "flag", "default", tc.evalCtx).Return(of.StringResolutionDetail{Value: "value"})
+
+				strategy, err := NewContextRoutingStrategy(providers, "tenant", routes, "0")
+				require.NoError(t, err)
+				assert.Equal(t, StrategyContextRouting, strategy.Name())
+				result := strategy.StringEvaluation(context.Background(), "flag", "default", tc.evalCtx)
+				assert.Equal(t, "value", result.Value)
+				assert.Equal(t, tc.provider, result.FlagMetadata[MetadataSuccessfulProviderName])
+				assert.Equal(t, tc.rule, result.FlagMetadata[MetadataRoutingRule])
+			})
+		}
+	})
+
+	t.Run("missing attribute without default is invalid context", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, _ := createMockProviders(ctrl, 3)
+
+		strategy, err := NewContextRoutingStrategy(providers, "tenant", routes, "")
+		require.NoError(t, err)
+		result := strategy.BooleanEvaluation(context.Background(), "flag", true, of.FlattenedContext{})
+		assert.True(t, result.Value)
+		assert.Equal(t, of.InvalidContextCode, result.ResolutionDetail().ErrorCode)
+		assert.Equal(t, of.ErrorReason, result.Reason)
+		assert.Equal(t, "none", result.FlagMetadata[MetadataSuccessfulProviderName])
+	})
+
+	t.Run("missing targeting key without default is targeting key missing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, _ := createMockProviders(ctrl, 3)
+
+		strategy, err := NewContextRoutingStrategy(providers, of.TargetingKey, routes, "")
+		require.NoError(t, err)
+		result := strategy.IntEvaluation(context.Background(), "flag", 1, of.FlattenedContext{})
+		assert.Equal(t, int64(1), result.Value)
+		assert.Equal(t, of.TargetingKeyMissingCode, result.ResolutionDetail().ErrorCode)
+	})
+
+	t.Run("unmapped value without default is invalid context", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, _ := createMockProviders(ctrl, 3)
+
+		strategy, err := NewContextRoutingStrategy(providers, "tenant", routes, "")
+		require.NoError(t, err)
+		result := strategy.FloatEvaluation(context.Background(), "flag", 1, of.FlattenedContext{"tenant": "initech"})
+		assert.Equal(t, of.InvalidContextCode, result.ResolutionDetail().ErrorCode)
+		assert.Equal(t, "no provider configured for tenant=initech", result.ResolutionDetail().ErrorMessage)
+	})
+
+	t.Run("unsupported attribute type is sent to the default provider", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 3)
+		evalCtx := of.FlattenedContext{"tenant": []string{"acme"}}
+		mocks["0"].EXPECT().ObjectEvaluation(gomock.Any(), "flag", nil, evalCtx).Return(of.InterfaceResolutionDetail{Value: "value"})
+
+		strategy, err := NewContextRoutingStrategy(providers, "tenant", routes, "0")
+		require.NoError(t, err)
+		result := strategy.ObjectEvaluation(context.Background(), "flag", nil, evalCtx)
+		assert.Equal(t, "value", result.Value)
+		assert.Equal(t, "0", result.FlagMetadata[MetadataSuccessfulProviderName])
+		assert.Equal(t, "default", result.FlagMetadata[MetadataRoutingRule])
+	})
+
+	t.Run("unsupported attribute type without default is invalid context", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, _ := createMockProviders(ctrl, 3)
+
+		strategy, err := NewContextRoutingStrategy(providers, "tenant", routes, "")
+		require.NoError(t, err)
+		result := strategy.ObjectEvaluation(context.Background(), "flag", nil, of.FlattenedContext{"tenant": []string{"acme"}})
+		assert.Equal(t, of.InvalidContextCode, result.ResolutionDetail().ErrorCode)
+	})
+
+	t.Run("invalid configuration", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, _ := createMockProviders(ctrl, 1)
+
+		_, err := NewContextRoutingStrategy(providers, "", nil, "0")
+		require.EqualError(t, err, "routing attribute cannot be the empty string")
+		_, err = NewContextRoutingStrategy(providers, "tenant", map[string]string{"acme": "unknown"}, "0")
+		require.EqualError(t, err, "route tenant=acme references unknown provider unknown")
+		_, err = NewContextRoutingStrategy(providers, "tenant", nil, "unknown")
+		require.EqualError(t, err, "default provider unknown is unknown")
+	})
+}
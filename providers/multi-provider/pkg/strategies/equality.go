@@ -0,0 +1,105 @@
+package strategies
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"reflect"
+)
+
+// ObjectComparator Reports whether two object flag values are equal
+type ObjectComparator func(a, b any) bool
+
+var _ ObjectComparator = JSONEqual
+
+// JSONEqual Compares two JSON-like values structurally. Numbers are compared by value regardless of their Go type, so an
+// int decoded by one provider equals the float64 decoded by another. Maps with string keys, slices & arrays are
+// compared element by element, pointers and interfaces are dereferenced and any other value, such as a struct, is
+// compared using its JSON representation.
+func JSONEqual(a, b any) bool {
+	return reflect.DeepEqual(normalizeJSON(a), normalizeJSON(b))
+}
+
+// normalizeJSON Converts a value into its canonical JSON-like form consisting of nil, bool, string, int64, float64,
+// []any and map[string]any. Integral numbers are represented as int64 whenever possible.
+func normalizeJSON(v any) any {
+	switch value := v.(type) {
+	case nil:
+		return nil
+	case bool, string:
+		return value
+	case json.Number:
+		if i, err := value.Int64(); err == nil {
+			return i
+		}
+		if f, err := value.Float64(); err == nil {
+			return normalizeFloat(f)
+		}
+		return value.String()
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Bool:
+		return rv.Bool()
+	case reflect.String:
+		return rv.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := rv.Uint()
+		if u <= math.MaxInt64 {
+			return int64(u)
+		}
+		return float64(u)
+	case reflect.Float32, reflect.Float64:
+		return normalizeFloat(rv.Float())
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return nil
+		}
+		return normalizeJSON(rv.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return nil
+		}
+		s := make([]any, rv.Len())
+		for i := range rv.Len() {
+			s[i] = normalizeJSON(rv.Index(i).Interface())
+		}
+		return s
+	case reflect.Map:
+		if rv.Type().Key().Kind() == reflect.String {
+			if rv.IsNil() {
+				return nil
+			}
+			m := make(map[string]any, rv.Len())
+			iter := rv.MapRange()
+			for iter.Next() {
+				m[iter.Key().String()] = normalizeJSON(iter.Value().Interface())
+			}
+			return m
+		}
+	}
+
+	// fall back to the JSON representation, e.g. for structs
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var decoded any
+	if err := decoder.Decode(&decoded); err != nil {
+		return v
+	}
+	return normalizeJSON(decoded)
+}
+
+// normalizeFloat Represents integral floats as int64, so they equal the same number decoded as an integer
+func normalizeFloat(f float64) any {
+	if f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 {
+		return int64(f)
+	}
+	return f
+}
@@ -0,0 +1,46 @@
+package strategies
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_JSONEqual(t *testing.T) {
+	type config struct {
+		Limit int      `json:"limit"`
+		Tags  []string `json:"tags"`
+	}
+	limit := 10
+
+	tests := map[string]struct {
+		a, b  any
+		equal bool
+	}{
+		"nil":                        {nil, nil, true},
+		"nil & empty map":            {nil, map[string]any{}, false},
+		"int & float":                {10, float64(10), true},
+		"int & fractional float":     {10, 10.5, false},
+		"json number & int":          {json.Number("10"), int64(10), true},
+		"uint & int":                 {uint8(3), 3, true},
+		"string & number":            {"10", 10, false},
+		"pointer & value":            {&limit, 10, true},
+		"typed & untyped slice":      {[]string{"a", "b"}, []any{"a", "b"}, true},
+		"slice order":                {[]any{"a", "b"}, []any{"b", "a"}, false},
+		"nested maps":                {map[string]any{"a": map[string]int{"b": 1}}, map[string]any{"a": map[string]any{"b": 1.0}}, true},
+		"nested maps differ":         {map[string]any{"a": map[string]int{"b": 1}}, map[string]any{"a": map[string]any{"b": 2.0}}, false},
+		"missing key":                {map[string]any{"a": 1}, map[string]any{"a": 1, "b": nil}, false},
+		"struct & map":               {config{Limit: 10, Tags: []string{"x"}}, map[string]any{"limit": 10.0, "tags": []any{"x"}}, true},
+		"struct & differing map":     {config{Limit: 10}, map[string]any{"limit": 11}, false},
+		"large ints stay exact":      {int64(1<<62 + 1), int64(1 << 62), false},
+		"large json number is exact": {json.Number("4611686018427387905"), int64(1<<62 + 1), true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.equal, JSONEqual(tt.a, tt.b))
+			assert.Equal(t, tt.equal, JSONEqual(tt.b, tt.a))
+		})
+	}
+}
@@ -2,6 +2,12 @@ package strategies
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	mperr "github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg/errors"
 	of "github.com/open-feature/go-sdk/openfeature"
 )
 
@@ -96,9 +102,18 @@ func (f *FirstMatchStrategy) ObjectEvaluation(ctx context.Context, flag string,
 }
 
 func evaluateFirstMatch[R resultConstraint, DV bool | string | int64 | float64 | interface{}](ctx context.Context, providers []*NamedProvider, e evaluator[R], defaultVal DV) resultWrapper[R] {
+	skipped := make([]string, 0)
+	notFound := make([]mperr.ProviderError, 0)
 	for _, provider := range providers {
+		if !provider.CircuitBreaker.allow() {
+			skipped = append(skipped, provider.Name)
+			continue
+		}
+		start := time.Now()
 		r := e(ctx, provider)
+		recordCircuitResult(ctx, provider, time.Since(start), r.detail)
 		if r.detail.Error() != nil && r.detail.ResolutionDetail().ErrorCode == of.FlagNotFoundCode {
+			notFound = append(notFound, mperr.ProviderError{ProviderName: provider.Name, Err: r.detail.ResolutionError})
 			continue
 		}
 		if r.detail.Error() != nil {
@@ -106,14 +121,27 @@ func evaluateFirstMatch[R resultConstraint, DV bool | string | int64 | float64 |
 				MetadataSuccessfulProviderName: "none",
 				MetadataStrategyUsed:           StrategyFirstMatch,
 			})
+			setSkippedProviders(r.detail.FlagMetadata, skipped)
+			setProviderErrors(r.detail.FlagMetadata, append(notFound, mperr.ProviderError{ProviderName: provider.Name, Err: r.detail.ResolutionError})...)
 			return r
 		}
 
 		// success!
-		r.detail.FlagMetadata = setFlagMetadata(StrategyFirstMatch, provider.Name, r.detail.FlagMetadata)
+		// the metadata belongs to the provider, which may return the same map for every evaluation
+		r.detail.FlagMetadata = setFlagMetadata(StrategyFirstMatch, provider.Name, maps.Clone(r.detail.FlagMetadata))
+		setSkippedProviders(r.detail.FlagMetadata, skipped)
 		return r
 	}
 
-	// Build a default result if no matches are found
-	return buildDefaultResult[R](StrategyFirstMatch, defaultVal, nil)
+	// Build a default result if no matches are found. If providers were skipped the flag might exist in one of them,
+	// so the result is reported as an error rather than FLAG_NOT_FOUND.
+	if len(skipped) > 0 {
+		r := buildDefaultResult[R](StrategyFirstMatch, defaultVal, fmt.Errorf("%w for providers: %s", ErrCircuitOpen, strings.Join(skipped, ", ")))
+		setSkippedProviders(r.detail.FlagMetadata, skipped)
+		setProviderErrors(r.detail.FlagMetadata, notFound...)
+		return r
+	}
+	r := buildDefaultResult[R](StrategyFirstMatch, defaultVal, nil)
+	setProviderErrors(r.detail.FlagMetadata, notFound...)
+	return r
 }
@@ -41,6 +41,21 @@ func Test_FirstMatchStrategy_BooleanEvaluation(t *testing.T) {
 		assert.Equal(t, providers[0].Name, result.FlagMetadata[MetadataSuccessfulProviderName])
 	})
 
+	t.Run("Metadata of the provider is not modified", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 1)
+		metadata := of.FlagMetadata{"owner": "team"}
+		mocks[providers[0].Name].EXPECT().
+			BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(of.BoolResolutionDetail{Value: true, ProviderResolutionDetail: of.ProviderResolutionDetail{FlagMetadata: metadata}})
+
+		strategy := NewFirstMatchStrategy(providers)
+		result := strategy.BooleanEvaluation(context.Background(), "test-string", false, of.FlattenedContext{})
+		assert.Equal(t, "team", result.FlagMetadata["owner"])
+		assert.Equal(t, providers[0].Name, result.FlagMetadata[MetadataSuccessfulProviderName])
+		assert.Equal(t, of.FlagMetadata{"owner": "team"}, metadata)
+	})
+
 	t.Run("Default Resolution", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		providers, mocks := createMockProviders(ctrl, 1)
@@ -115,6 +130,9 @@ func Test_FirstMatchStrategy_BooleanEvaluation(t *testing.T) {
 		assert.Equal(t, expectedErr.Error(), result.ResolutionError.Error())
 		assert.Equal(t, "none", result.FlagMetadata[MetadataSuccessfulProviderName])
 		assert.Equal(t, StrategyFirstMatch, result.FlagMetadata[MetadataStrategyUsed])
+		assert.Equal(t, of.FlagMetadata{
+			providers[0].Name: of.FlagMetadata{MetadataErrorCode: string(of.GeneralCode), MetadataErrorMessage: "something went wrong"},
+		}, result.FlagMetadata[MetadataProviderErrors])
 	})
 }
 
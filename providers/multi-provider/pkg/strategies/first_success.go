@@ -8,16 +8,35 @@ import (
 	mperr "github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg/errors"
 )
 
-type FirstSuccessStrategy struct {
-	providers []*NamedProvider
-	timeout   time.Duration
-}
+type (
+	FirstSuccessStrategy struct {
+		providers  []*NamedProvider
+		timeout    time.Duration
+		hedgeDelay time.Duration
+	}
+
+	// FirstSuccessOption Function used for configuring a FirstSuccessStrategy
+	FirstSuccessOption func(*FirstSuccessStrategy)
+)
 
 var _ Strategy = (*FirstSuccessStrategy)(nil)
 
+// WithHedgeDelay Enables hedging: instead of starting all providers at once, providers are started in order and the
+// next provider is only started if the providers started so far have not succeeded within the delay. A provider
+// returning an error starts the next provider immediately. The timeout remains the limit for the whole evaluation.
+func WithHedgeDelay(d time.Duration) FirstSuccessOption {
+	return func(f *FirstSuccessStrategy) {
+		f.hedgeDelay = d
+	}
+}
+
 // NewFirstSuccessStrategy Creates a new FirstSuccessStrategy instance as a Strategy
-func NewFirstSuccessStrategy(providers []*NamedProvider, timeout time.Duration) Strategy {
-	return &FirstSuccessStrategy{providers: providers, timeout: timeout}
+func NewFirstSuccessStrategy(providers []*NamedProvider, timeout time.Duration, opts ...FirstSuccessOption) Strategy {
+	f := &FirstSuccessStrategy{providers: providers, timeout: timeout}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
 }
 
 func (f *FirstSuccessStrategy) Name() EvaluationStrategy {
@@ -34,7 +53,7 @@ func (f *FirstSuccessStrategy) BooleanEvaluation(ctx context.Context, flag strin
 			detail: result.ProviderResolutionDetail,
 		}
 	}
-	result, metadata := evaluateFirstSuccess[of.BoolResolutionDetail](ctx, f.providers, evalFunc, defaultValue, f.timeout)
+	result, metadata := evaluateFirstSuccess[of.BoolResolutionDetail](ctx, f.providers, evalFunc, defaultValue, f.timeout, f.hedgeDelay)
 	r := *result.result
 	r.ProviderResolutionDetail.FlagMetadata = mergeFlagTags(r.ProviderResolutionDetail.FlagMetadata, metadata)
 	return r
@@ -50,7 +69,7 @@ func (f *FirstSuccessStrategy) StringEvaluation(ctx context.Context, flag string
 			detail: result.ProviderResolutionDetail,
 		}
 	}
-	result, metadata := evaluateFirstSuccess[of.StringResolutionDetail](ctx, f.providers, evalFunc, defaultValue, f.timeout)
+	result, metadata := evaluateFirstSuccess[of.StringResolutionDetail](ctx, f.providers, evalFunc, defaultValue, f.timeout, f.hedgeDelay)
 	r := *result.result
 	r.ProviderResolutionDetail.FlagMetadata = mergeFlagTags(r.ProviderResolutionDetail.FlagMetadata, metadata)
 	return r
@@ -66,7 +85,7 @@ func (f *FirstSuccessStrategy) FloatEvaluation(ctx context.Context, flag string,
 			detail: result.ProviderResolutionDetail,
 		}
 	}
-	result, metadata := evaluateFirstSuccess[of.FloatResolutionDetail](ctx, f.providers, evalFunc, defaultValue, f.timeout)
+	result, metadata := evaluateFirstSuccess[of.FloatResolutionDetail](ctx, f.providers, evalFunc, defaultValue, f.timeout, f.hedgeDelay)
 	r := *result.result
 	r.ProviderResolutionDetail.FlagMetadata = mergeFlagTags(r.ProviderResolutionDetail.FlagMetadata, metadata)
 	return r
@@ -82,7 +101,7 @@ func (f *FirstSuccessStrategy) IntEvaluation(ctx context.Context, flag string, d
 			detail: result.ProviderResolutionDetail,
 		}
 	}
-	result, metadata := evaluateFirstSuccess[of.IntResolutionDetail](ctx, f.providers, evalFunc, defaultValue, f.timeout)
+	result, metadata := evaluateFirstSuccess[of.IntResolutionDetail](ctx, f.providers, evalFunc, defaultValue, f.timeout, f.hedgeDelay)
 	r := *result.result
 	r.ProviderResolutionDetail.FlagMetadata = mergeFlagTags(r.ProviderResolutionDetail.FlagMetadata, metadata)
 	return r
@@ -98,78 +117,135 @@ func (f *FirstSuccessStrategy) ObjectEvaluation(ctx context.Context, flag string
 			detail: result.ProviderResolutionDetail,
 		}
 	}
-	result, metadata := evaluateFirstSuccess[of.InterfaceResolutionDetail](ctx, f.providers, evalFunc, defaultValue, f.timeout)
+	result, metadata := evaluateFirstSuccess[of.InterfaceResolutionDetail](ctx, f.providers, evalFunc, defaultValue, f.timeout, f.hedgeDelay)
 	r := *result.result
 	r.ProviderResolutionDetail.FlagMetadata = mergeFlagTags(r.ProviderResolutionDetail.FlagMetadata, metadata)
 	return r
 }
 
-func evaluateFirstSuccess[R resultConstraint, DV bool | string | int64 | float64 | interface{}](ctx context.Context, providers []*NamedProvider, e evaluator[R], defaultVal DV, timeout time.Duration) (resultWrapper[R], of.FlagMetadata) {
+func evaluateFirstSuccess[R resultConstraint, DV bool | string | int64 | float64 | interface{}](ctx context.Context, providers []*NamedProvider, e evaluator[R], defaultVal DV, timeout time.Duration, hedgeDelay time.Duration) (resultWrapper[R], of.FlagMetadata) {
 	metadata := make(of.FlagMetadata)
 	metadata[MetadataStrategyUsed] = StrategyFirstSuccess
 	errChan := make(chan mperr.ProviderError, len(providers))
-	notFoundChan := make(chan interface{})
+	notFoundChan := make(chan mperr.ProviderError, len(providers))
 	finishChan := make(chan *resultWrapper[R], len(providers))
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	for _, provider := range providers {
-		go func(c context.Context, p *NamedProvider) {
-			resultChan := make(chan *resultWrapper[R])
-			go func() {
-				r := e(c, provider)
-				resultChan <- &r
-			}()
-
-			select {
-			case <-c.Done():
-				return
-			case r := <-resultChan:
-				if r.detail.Error() != nil && r.detail.ResolutionDetail().ErrorCode == of.FlagNotFoundCode {
-					notFoundChan <- struct{}{}
+
+	errs := make([]mperr.ProviderError, 0, len(providers))
+	notFound := make([]mperr.ProviderError, 0, len(providers))
+	skipped := make([]string, 0)
+	next := 0
+	// launch Starts the evaluation of the next provider not skipped by its circuit breaker, reporting whether a provider
+	// was started
+	launch := func() bool {
+		for next < len(providers) {
+			provider := providers[next]
+			next++
+			if !provider.CircuitBreaker.allow() {
+				skipped = append(skipped, provider.Name)
+				errs = append(errs, mperr.ProviderError{ProviderName: provider.Name, Err: ErrCircuitOpen})
+				continue
+			}
+			go func(c context.Context, p *NamedProvider) {
+				resultChan := make(chan *resultWrapper[R], 1)
+				go func() {
+					begin := time.Now()
+					r := e(c, p)
+					recordCircuitResult(c, p, time.Since(begin), r.detail)
+					resultChan <- &r
+				}()
+
+				select {
+				case <-c.Done():
 					return
-				} else if r.detail.Error() != nil {
-					errChan <- mperr.ProviderError{
-						Err:          r.detail.ResolutionError,
-						ProviderName: p.Name,
+				case r := <-resultChan:
+					if r.detail.Error() != nil && r.detail.ResolutionDetail().ErrorCode == of.FlagNotFoundCode {
+						notFoundChan <- mperr.ProviderError{
+							Err:          r.detail.ResolutionError,
+							ProviderName: p.Name,
+						}
+						return
+					} else if r.detail.Error() != nil {
+						errChan <- mperr.ProviderError{
+							Err:          r.detail.ResolutionError,
+							ProviderName: p.Name,
+						}
+						return
 					}
-					return
+					finishChan <- r
 				}
-				finishChan <- r
-			}
+			}(ctx, provider)
+			return true
+		}
+		return false
+	}
 
-		}(ctx, provider)
+	// without hedging all providers are started at once, otherwise the next provider is started once the hedge delay
+	// has passed or a started provider failed to resolve the flag
+	var hedge <-chan time.Time
+	var hedgeTimer *time.Timer
+	if hedgeDelay > 0 {
+		launch()
+		hedgeTimer = time.NewTimer(hedgeDelay)
+		defer hedgeTimer.Stop()
+		hedge = hedgeTimer.C
+	} else {
+		for launch() {
+		}
+	}
+	startNext := func() {
+		if hedgeTimer == nil {
+			return
+		}
+		launch()
+		if next < len(providers) {
+			hedgeTimer.Reset(hedgeDelay)
+		} else {
+			hedge = nil
+		}
 	}
 
-	errs := make([]mperr.ProviderError, 0, len(providers))
-	notFoundCount := 0
 	for {
-		if len(errs) == len(providers) {
+		if len(errs) > 0 && len(errs)+len(notFound) == len(providers) {
+			SortByProviderOrder(providers, errs, func(e mperr.ProviderError) string { return e.ProviderName })
 			err := mperr.NewAggregateError(errs)
 			r := buildDefaultResult[R](StrategyFirstSuccess, defaultVal, err)
+			setSkippedProviders(r.detail.FlagMetadata, skipped)
+			setProviderErrors(r.detail.FlagMetadata, notFound...)
 			return r, r.detail.FlagMetadata
 		}
 
 		select {
 		case result := <-finishChan:
 			metadata[MetadataSuccessfulProviderName] = result.name
+			setSkippedProviders(metadata, skipped)
 			cancel()
 			return *result, metadata
 		case err := <-errChan:
 			errs = append(errs, err)
-		case <-notFoundChan:
-			notFoundCount += 1
-			if notFoundCount == len(providers) {
+			startNext()
+		case err := <-notFoundChan:
+			notFound = append(notFound, err)
+			if len(notFound) == len(providers) {
 				r := buildDefaultResult[R](StrategyFirstSuccess, defaultVal, nil)
+				setProviderErrors(r.detail.FlagMetadata, notFound...)
 				return r, r.detail.FlagMetadata
 			}
+			startNext()
+		case <-hedge:
+			startNext()
 		case <-ctx.Done():
 			var err error
 			if len(errs) > 0 {
+				SortByProviderOrder(providers, errs, func(e mperr.ProviderError) string { return e.ProviderName })
 				err = mperr.NewAggregateError(errs)
 			} else {
 				err = ctx.Err()
 			}
 			r := buildDefaultResult[R](StrategyFirstSuccess, defaultVal, err)
+			setSkippedProviders(r.detail.FlagMetadata, skipped)
+			setProviderErrors(r.detail.FlagMetadata, notFound...)
 			return r, r.detail.FlagMetadata
 		}
 	}
@@ -763,3 +763,156 @@ func Test_FirstSuccessStrategy_ObjectEvaluation(t *testing.T) {
 		assert.Equal(t, of.DefaultReason, result.Reason)
 	})
 }
+
+func Test_FirstSuccessStrategy_Hedging(t *testing.T) {
+	t.Run("next provider is not started if the first one succeeds within the delay", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		provider1 := mocks.NewMockFeatureProvider(ctrl)
+		configureFirstSuccessProvider(provider1, true, true, TestErrorNone, 5*time.Millisecond)
+		provider2 := mocks.NewMockFeatureProvider(ctrl)
+		provider2.EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		strategy := NewFirstSuccessStrategy([]*NamedProvider{
+			{Name: "provider1", Provider: provider1},
+			{Name: "provider2", Provider: provider2},
+		}, 2*time.Second, WithHedgeDelay(500*time.Millisecond))
+
+		result := strategy.BooleanEvaluation(context.Background(), TestFlag, false, of.FlattenedContext{})
+		assert.True(t, result.Value)
+		assert.Equal(t, "provider1", result.FlagMetadata[MetadataSuccessfulProviderName])
+	})
+
+	t.Run("next provider is started after the delay", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		provider1 := mocks.NewMockFeatureProvider(ctrl)
+		configureFirstSuccessProvider(provider1, true, true, TestErrorNone, 500*time.Millisecond)
+		provider2 := mocks.NewMockFeatureProvider(ctrl)
+		configureFirstSuccessProvider(provider2, true, true, TestErrorNone, 5*time.Millisecond)
+
+		strategy := NewFirstSuccessStrategy([]*NamedProvider{
+			{Name: "provider1", Provider: provider1},
+			{Name: "provider2", Provider: provider2},
+		}, 2*time.Second, WithHedgeDelay(20*time.Millisecond))
+
+		start := time.Now()
+		result := strategy.BooleanEvaluation(context.Background(), TestFlag, false, of.FlattenedContext{})
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+		assert.True(t, result.Value)
+		assert.Equal(t, "provider2", result.FlagMetadata[MetadataSuccessfulProviderName])
+	})
+
+	t.Run("error starts the next provider immediately", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		provider1 := mocks.NewMockFeatureProvider(ctrl)
+		configureFirstSuccessProvider(provider1, false, false, TestErrorError, 0)
+		provider2 := mocks.NewMockFeatureProvider(ctrl)
+		configureFirstSuccessProvider(provider2, false, false, TestErrorNotFound, 0)
+		provider3 := mocks.NewMockFeatureProvider(ctrl)
+		configureFirstSuccessProvider(provider3, true, true, TestErrorNone, 0)
+
+		strategy := NewFirstSuccessStrategy([]*NamedProvider{
+			{Name: "provider1", Provider: provider1},
+			{Name: "provider2", Provider: provider2},
+			{Name: "provider3", Provider: provider3},
+		}, 2*time.Second, WithHedgeDelay(time.Second))
+
+		start := time.Now()
+		result := strategy.BooleanEvaluation(context.Background(), TestFlag, false, of.FlattenedContext{})
+		assert.Less(t, time.Since(start), time.Second)
+		assert.True(t, result.Value)
+		assert.Equal(t, "provider3", result.FlagMetadata[MetadataSuccessfulProviderName])
+	})
+
+	t.Run("timeout limits the whole evaluation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		provider1 := mocks.NewMockFeatureProvider(ctrl)
+		configureFirstSuccessProvider(provider1, true, true, TestErrorNone, 500*time.Millisecond)
+		provider2 := mocks.NewMockFeatureProvider(ctrl)
+		configureFirstSuccessProvider(provider2, true, true, TestErrorNone, 500*time.Millisecond)
+
+		strategy := NewFirstSuccessStrategy([]*NamedProvider{
+			{Name: "provider1", Provider: provider1},
+			{Name: "provider2", Provider: provider2},
+		}, 50*time.Millisecond, WithHedgeDelay(10*time.Millisecond))
+
+		start := time.Now()
+		result := strategy.BooleanEvaluation(context.Background(), TestFlag, false, of.FlattenedContext{})
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+		assert.False(t, result.Value)
+		assert.Equal(t, of.ErrorReason, result.Reason)
+		assert.Equal(t, "none", result.FlagMetadata[MetadataSuccessfulProviderName])
+	})
+
+	t.Run("all errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		provider1 := mocks.NewMockFeatureProvider(ctrl)
+		configureFirstSuccessProvider(provider1, false, false, TestErrorError, 0)
+		provider2 := mocks.NewMockFeatureProvider(ctrl)
+		configureFirstSuccessProvider(provider2, false, false, TestErrorError, 0)
+
+		strategy := NewFirstSuccessStrategy([]*NamedProvider{
+			{Name: "provider1", Provider: provider1},
+			{Name: "provider2", Provider: provider2},
+		}, 2*time.Second, WithHedgeDelay(time.Second))
+
+		result := strategy.BooleanEvaluation(context.Background(), TestFlag, false, of.FlattenedContext{})
+		assert.Equal(t, of.ErrorReason, result.Reason)
+		assert.Equal(t, of.GeneralCode, result.ResolutionDetail().ErrorCode)
+		assert.Contains(t, result.ResolutionDetail().ErrorMessage, "provider2")
+	})
+}
+
+func Test_FirstSuccessStrategy_ProviderErrors(t *testing.T) {
+	notReady := func(provider *mocks.MockFeatureProvider) {
+		provider.EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.BoolResolutionDetail{
+			ProviderResolutionDetail: of.ProviderResolutionDetail{
+				ResolutionError: of.NewProviderNotReadyResolutionError("connecting"),
+				Reason:          of.ErrorReason,
+			},
+		})
+	}
+
+	t.Run("all not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 2)
+		configureFirstSuccessProvider(mocks["0"], false, false, TestErrorNotFound, 0)
+		configureFirstSuccessProvider(mocks["1"], false, false, TestErrorNotFound, 0)
+
+		result := NewFirstSuccessStrategy(providers, time.Second).BooleanEvaluation(context.Background(), TestFlag, false, of.FlattenedContext{})
+		assert.Equal(t, of.FlagNotFoundCode, result.ResolutionDetail().ErrorCode)
+		assert.Equal(t, of.FlagMetadata{
+			"0": of.FlagMetadata{MetadataErrorCode: string(of.FlagNotFoundCode), MetadataErrorMessage: "test not found"},
+			"1": of.FlagMetadata{MetadataErrorCode: string(of.FlagNotFoundCode), MetadataErrorMessage: "test not found"},
+		}, result.FlagMetadata[MetadataProviderErrors])
+	})
+
+	t.Run("single provider not ready", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 2)
+		configureFirstSuccessProvider(mocks["0"], false, false, TestErrorNotFound, 0)
+		notReady(mocks["1"])
+
+		result := NewFirstSuccessStrategy(providers, time.Second).BooleanEvaluation(context.Background(), TestFlag, false, of.FlattenedContext{})
+		assert.Equal(t, of.ProviderNotReadyCode, result.ResolutionDetail().ErrorCode)
+		assert.Equal(t, "Provider 1: connecting", result.ResolutionDetail().ErrorMessage)
+		assert.Equal(t, of.FlagMetadata{
+			"0": of.FlagMetadata{MetadataErrorCode: string(of.FlagNotFoundCode), MetadataErrorMessage: "test not found"},
+			"1": of.FlagMetadata{MetadataErrorCode: string(of.ProviderNotReadyCode), MetadataErrorMessage: "connecting"},
+		}, result.FlagMetadata[MetadataProviderErrors])
+	})
+
+	t.Run("differing errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 2)
+		configureFirstSuccessProvider(mocks["0"], false, false, TestErrorError, 0)
+		notReady(mocks["1"])
+
+		result := NewFirstSuccessStrategy(providers, time.Second).BooleanEvaluation(context.Background(), TestFlag, false, of.FlattenedContext{})
+		assert.Equal(t, of.GeneralCode, result.ResolutionDetail().ErrorCode)
+		providerErrors, ok := result.FlagMetadata[MetadataProviderErrors].(of.FlagMetadata)
+		if assert.True(t, ok) {
+			assert.Equal(t, string(of.GeneralCode), providerErrors["0"].(of.FlagMetadata)[MetadataErrorCode])
+			assert.Equal(t, string(of.ProviderNotReadyCode), providerErrors["1"].(of.FlagMetadata)[MetadataErrorCode])
+		}
+	})
+}
@@ -0,0 +1,131 @@
+package strategies
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+)
+
+type (
+	// FlagKeyRoutingStrategy Routes each evaluation to exactly one provider based on the flag key. Routes are matched in
+	// order and the first matching route wins. Flags not matching any route are sent to the default provider.
+	FlagKeyRoutingStrategy struct {
+		routingStrategy
+		routes []FlagKeyRoute
+	}
+
+	// FlagKeyRoute A rule matching flag keys which are routed to the named provider
+	FlagKeyRoute struct {
+		// Provider The name of the provider matching flags are routed to
+		Provider string
+		rule     string
+		match    func(flag string) bool
+	}
+)
+
+var _ Strategy = (*FlagKeyRoutingStrategy)(nil)
+
+// ExactKeyRoute Routes the flag with exactly the given key to the named provider
+func ExactKeyRoute(key string, provider string) FlagKeyRoute {
+	return FlagKeyRoute{
+		Provider: provider,
+		rule:     "exact:" + key,
+		match: func(flag string) bool {
+			return flag == key
+		},
+	}
+}
+
+// PrefixKeyRoute Routes all flags whose key starts with the prefix to the named provider
+func PrefixKeyRoute(prefix string, provider string) FlagKeyRoute {
+	return FlagKeyRoute{
+		Provider: provider,
+		rule:     "prefix:" + prefix,
+		match: func(flag string) bool {
+			return strings.HasPrefix(flag, prefix)
+		},
+	}
+}
+
+// GlobKeyRoute Routes all flags whose key matches the glob pattern to the named provider. The pattern syntax is the
+// one of path.Match, e.g. `billing.*`.
+func GlobKeyRoute(pattern string, provider string) (FlagKeyRoute, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return FlagKeyRoute{}, fmt.Errorf("invalid glob pattern %q: %w", pattern, err)
+	}
+	return FlagKeyRoute{
+		Provider: provider,
+		rule:     "glob:" + pattern,
+		match: func(flag string) bool {
+			matched, _ := path.Match(pattern, flag)
+			return matched
+		},
+	}, nil
+}
+
+// RegexKeyRoute Routes all flags whose key matches the regular expression to the named provider
+func RegexKeyRoute(expr string, provider string) (FlagKeyRoute, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return FlagKeyRoute{}, fmt.Errorf("invalid regular expression %q: %w", expr, err)
+	}
+	return FlagKeyRoute{
+		Provider: provider,
+		rule:     "regex:" + expr,
+		match:    re.MatchString,
+	}, nil
+}
+
+// String The description of the route as recorded in the flag metadata
+func (r FlagKeyRoute) String() string {
+	return r.rule
+}
+
+// NewFlagKeyRoutingStrategy Creates a new FlagKeyRoutingStrategy instance. Every route as well as the default provider
+// must name one of the providers. The default provider may be empty, in which case flags not matching any route
+// resolve to FLAG_NOT_FOUND.
+func NewFlagKeyRoutingStrategy(providers []*NamedProvider, routes []FlagKeyRoute, defaultProvider string) (*FlagKeyRoutingStrategy, error) {
+	byName := make(map[string]*NamedProvider, len(providers))
+	for _, p := range providers {
+		byName[p.Name] = p
+	}
+
+	for i, r := range routes {
+		if r.match == nil {
+			return nil, fmt.Errorf("route %d must be created using one of the route constructors", i)
+		}
+		if _, ok := byName[r.Provider]; !ok {
+			return nil, fmt.Errorf("route %s references unknown provider %s", r.rule, r.Provider)
+		}
+	}
+
+	var fallback *NamedProvider
+	if defaultProvider != "" {
+		p, ok := byName[defaultProvider]
+		if !ok {
+			return nil, fmt.Errorf("default provider %s is unknown", defaultProvider)
+		}
+		fallback = p
+	}
+
+	s := &FlagKeyRoutingStrategy{routes: routes}
+	s.routingStrategy = routingStrategy{
+		name: StrategyFlagKeyRouting,
+		route: func(flag string, _ of.FlattenedContext) (*NamedProvider, string, of.ResolutionError) {
+			for _, r := range s.routes {
+				if r.match(flag) {
+					return byName[r.Provider], r.rule, of.ResolutionError{}
+				}
+			}
+			if fallback != nil {
+				return fallback, "default", of.ResolutionError{}
+			}
+			return nil, "", of.NewFlagNotFoundResolutionError(fmt.Sprintf("no route matches flag %s", flag))
+		},
+	}
+
+	return s, nil
+}
@@ -0,0 +1,115 @@
+package strategies
+
+import (
+	"context"
+	"testing"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_FlagKeyRoutingStrategy(t *testing.T) {
+	newRoutes := func(t *testing.T) []FlagKeyRoute {
+		glob, err := GlobKeyRoute("billing.*", "1")
+		require.NoError(t, err)
+		regex, err := RegexKeyRoute(`^ops-\d+$`, "2")
+		require.NoError(t, err)
+		return []FlagKeyRoute{
+			ExactKeyRoute("billing.override", "2"),
+			glob,
+			PrefixKeyRoute("local.", "0"),
+			regex,
+		}
+	}
+
+	t.Run("routes by flag key", func(t *testing.T) {
+		cases := map[string]struct {
+			provider string
+			rule     string
+		}{
+			"billing.override": {provider: "2", rule: "exact:billing.override"},
+			"billing.enabled":  {provider: "1", rule: "glob:billing.*"},
+			"local.feature":    {provider: "0", rule: "prefix:local."},
+			"ops-42":           {provider: "2", rule: `regex:^ops-\d+$`},
+			"other":            {provider: "0", rule: "default"},
+		}
+		for flag, tc := range cases {
+			t.Run(flag, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				providers, mocks := createMockProviders(ctrl, 3)
+				mocks[tc.provider].EXPECT().BooleanEvaluation(gomock.Any(), flag, false, gomock.Any()).Return(of.BoolResolutionDetail{Value: true})
+
+				strategy, err := NewFlagKeyRoutingStrategy(providers, newRoutes(t), "0")
+				require.NoError(t, err)
+				assert.Equal(t, StrategyFlagKeyRouting, strategy.Name())
+				result := strategy.BooleanEvaluation(context.Background(), flag, false, of.FlattenedContext{})
+				assert.True(t, result.Value)
+				assert.Equal(t, tc.provider, result.FlagMetadata[MetadataSuccessfulProviderName])
+				assert.Equal(t, tc.rule, result.FlagMetadata[MetadataRoutingRule])
+				assert.Equal(t, StrategyFlagKeyRouting, result.FlagMetadata[MetadataStrategyUsed])
+			})
+		}
+	})
+
+	t.Run("all types are routed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 2)
+		mocks["1"].EXPECT().StringEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.StringResolutionDetail{Value: "value"})
+		mocks["1"].EXPECT().IntEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.IntResolutionDetail{Value: 1})
+		mocks["1"].EXPECT().FloatEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.FloatResolutionDetail{Value: 1.5})
+		mocks["1"].EXPECT().ObjectEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.InterfaceResolutionDetail{Value: "object"})
+
+		strategy, err := NewFlagKeyRoutingStrategy(providers, []FlagKeyRoute{PrefixKeyRoute("flag", "1")}, "0")
+		require.NoError(t, err)
+		assert.Equal(t, "value", strategy.StringEvaluation(context.Background(), "flag", "", of.FlattenedContext{}).Value)
+		assert.Equal(t, int64(1), strategy.IntEvaluation(context.Background(), "flag", 0, of.FlattenedContext{}).Value)
+		assert.Equal(t, 1.5, strategy.FloatEvaluation(context.Background(), "flag", 0, of.FlattenedContext{}).Value)
+		assert.Equal(t, "object", strategy.ObjectEvaluation(context.Background(), "flag", nil, of.FlattenedContext{}).Value)
+	})
+
+	t.Run("no match without default provider is not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, _ := createMockProviders(ctrl, 2)
+
+		strategy, err := NewFlagKeyRoutingStrategy(providers, []FlagKeyRoute{ExactKeyRoute("flag", "1")}, "")
+		require.NoError(t, err)
+		result := strategy.StringEvaluation(context.Background(), "other", "default", of.FlattenedContext{})
+		assert.Equal(t, "default", result.Value)
+		assert.Equal(t, of.FlagNotFoundCode, result.ResolutionDetail().ErrorCode)
+		assert.Equal(t, of.DefaultReason, result.Reason)
+		assert.Equal(t, "none", result.FlagMetadata[MetadataSuccessfulProviderName])
+	})
+
+	t.Run("provider errors are returned", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 1)
+		mocks["0"].EXPECT().IntEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.IntResolutionDetail{
+			Value:                    5,
+			ProviderResolutionDetail: of.ProviderResolutionDetail{ResolutionError: of.NewGeneralResolutionError("boom"), Reason: of.ErrorReason},
+		})
+
+		strategy, err := NewFlagKeyRoutingStrategy(providers, nil, "0")
+		require.NoError(t, err)
+		result := strategy.IntEvaluation(context.Background(), "flag", 5, of.FlattenedContext{})
+		assert.Equal(t, of.GeneralCode, result.ResolutionDetail().ErrorCode)
+		assert.Equal(t, "0", result.FlagMetadata[MetadataSuccessfulProviderName])
+	})
+
+	t.Run("invalid configuration", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, _ := createMockProviders(ctrl, 1)
+
+		_, err := NewFlagKeyRoutingStrategy(providers, []FlagKeyRoute{ExactKeyRoute("flag", "unknown")}, "0")
+		require.EqualError(t, err, "route exact:flag references unknown provider unknown")
+		_, err = NewFlagKeyRoutingStrategy(providers, nil, "unknown")
+		require.EqualError(t, err, "default provider unknown is unknown")
+		_, err = NewFlagKeyRoutingStrategy(providers, []FlagKeyRoute{{Provider: "0"}}, "0")
+		require.Error(t, err)
+		_, err = GlobKeyRoute("[", "0")
+		require.Error(t, err)
+		_, err = RegexKeyRoute("(", "0")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,188 @@
+package strategies
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+)
+
+// hookedProvider wraps a provider and executes the hooks returned by its Hooks method around each of its own
+// evaluations. Each evaluation uses its own hook context, so changes made by the hooks of one provider never leak into
+// the evaluation of another provider.
+type hookedProvider struct {
+	of.FeatureProvider
+}
+
+var _ of.FeatureProvider = (*hookedProvider)(nil)
+
+// NewHookedProvider Wraps a provider so that the before, after, error & finally hooks returned by its Hooks method are
+// executed around each evaluation of that provider. The wrapped provider does not return any hooks itself.
+func NewHookedProvider(provider of.FeatureProvider) of.FeatureProvider {
+	if _, ok := provider.(*hookedProvider); ok || provider == nil {
+		return provider
+	}
+	return &hookedProvider{FeatureProvider: provider}
+}
+
+// NewHookedProviders Wraps each provider using NewHookedProvider, retaining the names, circuit breakers and order of
+// the providers
+func NewHookedProviders(providers []*NamedProvider) []*NamedProvider {
+	hooked := make([]*NamedProvider, 0, len(providers))
+	for _, p := range providers {
+		hooked = append(hooked, &NamedProvider{Name: p.Name, Provider: NewHookedProvider(p.Provider), CircuitBreaker: p.CircuitBreaker})
+	}
+	return hooked
+}
+
+// Hooks The hooks of the wrapped provider are executed by the hookedProvider, so none are returned
+func (h *hookedProvider) Hooks() []of.Hook {
+	return []of.Hook{}
+}
+
+func (h *hookedProvider) BooleanEvaluation(ctx context.Context, flag string, defaultValue bool, evalCtx of.FlattenedContext) of.BoolResolutionDetail {
+	return evaluateWithHooks(ctx, h.FeatureProvider, flag, of.Boolean, defaultValue, evalCtx, h.FeatureProvider.BooleanEvaluation)
+}
+
+func (h *hookedProvider) StringEvaluation(ctx context.Context, flag string, defaultValue string, evalCtx of.FlattenedContext) of.StringResolutionDetail {
+	return evaluateWithHooks(ctx, h.FeatureProvider, flag, of.String, defaultValue, evalCtx, h.FeatureProvider.StringEvaluation)
+}
+
+func (h *hookedProvider) FloatEvaluation(ctx context.Context, flag string, defaultValue float64, evalCtx of.FlattenedContext) of.FloatResolutionDetail {
+	return evaluateWithHooks(ctx, h.FeatureProvider, flag, of.Float, defaultValue, evalCtx, h.FeatureProvider.FloatEvaluation)
+}
+
+func (h *hookedProvider) IntEvaluation(ctx context.Context, flag string, defaultValue int64, evalCtx of.FlattenedContext) of.IntResolutionDetail {
+	return evaluateWithHooks(ctx, h.FeatureProvider, flag, of.Int, defaultValue, evalCtx, h.FeatureProvider.IntEvaluation)
+}
+
+func (h *hookedProvider) ObjectEvaluation(ctx context.Context, flag string, defaultValue interface{}, evalCtx of.FlattenedContext) of.InterfaceResolutionDetail {
+	return evaluateWithHooks(ctx, h.FeatureProvider, flag, of.Object, defaultValue, evalCtx, h.FeatureProvider.ObjectEvaluation)
+}
+
+// evaluateWithHooks Executes the evaluation of a single provider wrapped by its hooks. Before hooks are executed in
+// order, after, error & finally hooks in reverse order. An error returned by a before or after hook results in the
+// default value being returned along with a general resolution error.
+func evaluateWithHooks[T any](
+	ctx context.Context,
+	provider of.FeatureProvider,
+	flag string,
+	flagType of.Type,
+	defaultValue T,
+	flatCtx of.FlattenedContext,
+	evaluate func(context.Context, string, T, of.FlattenedContext) of.GenericResolutionDetail[T],
+) of.GenericResolutionDetail[T] {
+	hooks := provider.Hooks()
+	if len(hooks) == 0 {
+		return evaluate(ctx, flag, defaultValue, flatCtx)
+	}
+
+	evalCtx := deepenContext(flatCtx)
+	hookCtx := of.NewHookContext(flag, flagType, defaultValue, of.NewClientMetadata(""), provider.Metadata(), evalCtx)
+	hints := of.NewHookHints(nil)
+	evalDetails := of.InterfaceEvaluationDetails{
+		Value: defaultValue,
+		EvaluationDetails: of.EvaluationDetails{
+			FlagKey:  flag,
+			FlagType: flagType,
+		},
+	}
+
+	defer func() {
+		for _, hook := range slices.Backward(hooks) {
+			hook.Finally(ctx, hookCtx, evalDetails, hints)
+		}
+	}()
+
+	hookFailure := func(err error) of.GenericResolutionDetail[T] {
+		for _, hook := range slices.Backward(hooks) {
+			hook.Error(ctx, hookCtx, err, hints)
+		}
+		evalDetails.Value = defaultValue
+		evalDetails.ResolutionDetail = of.ResolutionDetail{
+			Reason:       of.ErrorReason,
+			ErrorCode:    of.GeneralCode,
+			ErrorMessage: err.Error(),
+		}
+		return of.GenericResolutionDetail[T]{
+			Value: defaultValue,
+			ProviderResolutionDetail: of.ProviderResolutionDetail{
+				ResolutionError: of.NewGeneralResolutionError(err.Error()),
+				Reason:          of.ErrorReason,
+			},
+		}
+	}
+
+	for _, hook := range hooks {
+		result, err := hook.Before(ctx, hookCtx, hints)
+		if result != nil {
+			evalCtx = mergeContexts(*result, evalCtx)
+			hookCtx = of.NewHookContext(flag, flagType, defaultValue, hookCtx.ClientMetadata(), hookCtx.ProviderMetadata(), evalCtx)
+		}
+		if err != nil {
+			return hookFailure(fmt.Errorf("before hook: %w", err))
+		}
+	}
+
+	result := evaluate(ctx, flag, defaultValue, flattenContext(evalCtx))
+	evalDetails.ResolutionDetail = result.ResolutionDetail()
+	if err := result.Error(); err != nil {
+		for _, hook := range slices.Backward(hooks) {
+			hook.Error(ctx, hookCtx, err, hints)
+		}
+		return result
+	}
+	evalDetails.Value = result.Value
+
+	for _, hook := range slices.Backward(hooks) {
+		if err := hook.After(ctx, hookCtx, evalDetails, hints); err != nil {
+			return hookFailure(fmt.Errorf("after hook: %w", err))
+		}
+	}
+
+	return result
+}
+
+// deepenContext Converts a FlattenedContext into an EvaluationContext
+func deepenContext(flatCtx of.FlattenedContext) of.EvaluationContext {
+	attributes := make(map[string]any, len(flatCtx))
+	var targetingKey string
+	for k, v := range flatCtx {
+		if k == of.TargetingKey {
+			targetingKey, _ = v.(string)
+			continue
+		}
+		attributes[k] = v
+	}
+	return of.NewEvaluationContext(targetingKey, attributes)
+}
+
+// flattenContext Converts an EvaluationContext into a FlattenedContext
+func flattenContext(evalCtx of.EvaluationContext) of.FlattenedContext {
+	flatCtx := evalCtx.Attributes()
+	if targetingKey := evalCtx.TargetingKey(); targetingKey != "" {
+		flatCtx[of.TargetingKey] = targetingKey
+	}
+	return flatCtx
+}
+
+// mergeContexts Merges the given contexts, earlier contexts take precedence over later ones
+func mergeContexts(evaluationContexts ...of.EvaluationContext) of.EvaluationContext {
+	if len(evaluationContexts) == 0 {
+		return of.EvaluationContext{}
+	}
+	attributes := evaluationContexts[0].Attributes()
+	targetingKey := evaluationContexts[0].TargetingKey()
+	for _, evalCtx := range evaluationContexts[1:] {
+		if targetingKey == "" {
+			targetingKey = evalCtx.TargetingKey()
+		}
+		for k, v := range evalCtx.Attributes() {
+			if _, ok := attributes[k]; !ok {
+				attributes[k] = v
+			}
+		}
+	}
+	return of.NewEvaluationContext(targetingKey, attributes)
+}
@@ -0,0 +1,171 @@
+package strategies
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	m "github.com/open-feature/go-sdk-contrib/providers/multi-provider/internal/mocks"
+	of "github.com/open-feature/go-sdk/openfeature"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type recordingHook struct {
+	of.UnimplementedHook
+	mu          sync.Mutex
+	name        string
+	calls       *[]string
+	beforeCtx   *of.EvaluationContext
+	beforeErr   error
+	afterErr    error
+	lastDetails of.InterfaceEvaluationDetails
+}
+
+func (h *recordingHook) record(stage string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	*h.calls = append(*h.calls, h.name+"-"+stage)
+}
+
+func (h *recordingHook) Before(_ context.Context, _ of.HookContext, _ of.HookHints) (*of.EvaluationContext, error) {
+	h.record("before")
+	return h.beforeCtx, h.beforeErr
+}
+
+func (h *recordingHook) After(_ context.Context, _ of.HookContext, details of.InterfaceEvaluationDetails, _ of.HookHints) error {
+	h.record("after")
+	return h.afterErr
+}
+
+func (h *recordingHook) Error(_ context.Context, _ of.HookContext, _ error, _ of.HookHints) {
+	h.record("error")
+}
+
+func (h *recordingHook) Finally(_ context.Context, _ of.HookContext, details of.InterfaceEvaluationDetails, _ of.HookHints) {
+	h.record("finally")
+	h.lastDetails = details
+}
+
+func Test_HookedProvider(t *testing.T) {
+	t.Run("no hooks evaluates provider directly", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		provider := m.NewMockFeatureProvider(ctrl)
+		provider.EXPECT().Hooks().Return(nil)
+		provider.EXPECT().BooleanEvaluation(gomock.Any(), "flag", false, gomock.Any()).Return(of.BoolResolutionDetail{Value: true})
+
+		result := NewHookedProvider(provider).BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+		assert.True(t, result.Value)
+	})
+
+	t.Run("hooks are executed around the evaluation in order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		var calls []string
+		hook1 := &recordingHook{name: "1", calls: &calls}
+		hook2 := &recordingHook{name: "2", calls: &calls}
+		provider := m.NewMockFeatureProvider(ctrl)
+		provider.EXPECT().Hooks().Return([]of.Hook{hook1, hook2})
+		provider.EXPECT().Metadata().Return(of.Metadata{Name: "mock"})
+		provider.EXPECT().StringEvaluation(gomock.Any(), "flag", "default", gomock.Any()).Return(of.StringResolutionDetail{
+			Value:                    "value",
+			ProviderResolutionDetail: of.ProviderResolutionDetail{Variant: "on", Reason: of.StaticReason},
+		})
+
+		hooked := NewHookedProvider(provider)
+		assert.Empty(t, hooked.Hooks())
+		result := hooked.StringEvaluation(context.Background(), "flag", "default", of.FlattenedContext{})
+		assert.Equal(t, "value", result.Value)
+		assert.Equal(t, []string{"1-before", "2-before", "2-after", "1-after", "2-finally", "1-finally"}, calls)
+		assert.Equal(t, "value", hook1.lastDetails.Value)
+		assert.Equal(t, "on", hook1.lastDetails.Variant)
+	})
+
+	t.Run("context returned by before hooks is passed to the provider", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		var calls []string
+		hookCtx := of.NewEvaluationContext("user", map[string]any{"enriched": true})
+		hook := &recordingHook{name: "1", calls: &calls, beforeCtx: &hookCtx}
+		provider := m.NewMockFeatureProvider(ctrl)
+		provider.EXPECT().Hooks().Return([]of.Hook{hook})
+		provider.EXPECT().Metadata().Return(of.Metadata{Name: "mock"})
+		provider.EXPECT().IntEvaluation(gomock.Any(), "flag", int64(0), of.FlattenedContext{
+			of.TargetingKey: "user",
+			"enriched":      true,
+			"original":      "value",
+		}).Return(of.IntResolutionDetail{Value: 1})
+
+		result := NewHookedProvider(provider).IntEvaluation(context.Background(), "flag", 0, of.FlattenedContext{"original": "value"})
+		assert.Equal(t, int64(1), result.Value)
+	})
+
+	t.Run("evaluation error executes error hooks", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		var calls []string
+		hook := &recordingHook{name: "1", calls: &calls}
+		provider := m.NewMockFeatureProvider(ctrl)
+		provider.EXPECT().Hooks().Return([]of.Hook{hook})
+		provider.EXPECT().Metadata().Return(of.Metadata{Name: "mock"})
+		provider.EXPECT().FloatEvaluation(gomock.Any(), "flag", 1.0, gomock.Any()).Return(of.FloatResolutionDetail{
+			Value:                    1.0,
+			ProviderResolutionDetail: of.ProviderResolutionDetail{ResolutionError: of.NewFlagNotFoundResolutionError("not found")},
+		})
+
+		result := NewHookedProvider(provider).FloatEvaluation(context.Background(), "flag", 1.0, of.FlattenedContext{})
+		assert.Equal(t, of.FlagNotFoundCode, result.ResolutionDetail().ErrorCode)
+		assert.Equal(t, []string{"1-before", "1-error", "1-finally"}, calls)
+		assert.Equal(t, of.FlagNotFoundCode, hook.lastDetails.ErrorCode)
+	})
+
+	t.Run("before hook error skips evaluation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		var calls []string
+		hook := &recordingHook{name: "1", calls: &calls, beforeErr: errors.New("boom")}
+		provider := m.NewMockFeatureProvider(ctrl)
+		provider.EXPECT().Hooks().Return([]of.Hook{hook})
+		provider.EXPECT().Metadata().Return(of.Metadata{Name: "mock"})
+
+		result := NewHookedProvider(provider).BooleanEvaluation(context.Background(), "flag", true, of.FlattenedContext{})
+		assert.True(t, result.Value)
+		assert.Equal(t, of.GeneralCode, result.ResolutionDetail().ErrorCode)
+		assert.Equal(t, "before hook: boom", result.ResolutionDetail().ErrorMessage)
+		assert.Equal(t, []string{"1-before", "1-error", "1-finally"}, calls)
+	})
+
+	t.Run("after hook error returns the default value", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		var calls []string
+		hook := &recordingHook{name: "1", calls: &calls, afterErr: errors.New("boom")}
+		provider := m.NewMockFeatureProvider(ctrl)
+		provider.EXPECT().Hooks().Return([]of.Hook{hook})
+		provider.EXPECT().Metadata().Return(of.Metadata{Name: "mock"})
+		provider.EXPECT().ObjectEvaluation(gomock.Any(), "flag", gomock.Any(), gomock.Any()).Return(of.InterfaceResolutionDetail{Value: "value"})
+
+		result := NewHookedProvider(provider).ObjectEvaluation(context.Background(), "flag", "default", of.FlattenedContext{})
+		assert.Equal(t, "default", result.Value)
+		assert.Equal(t, of.GeneralCode, result.ResolutionDetail().ErrorCode)
+		assert.Equal(t, []string{"1-before", "1-after", "1-error", "1-finally"}, calls)
+	})
+
+	t.Run("hooks are isolated per provider within a strategy", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		var calls []string
+		hook1 := &recordingHook{name: "1", calls: &calls}
+		hook2 := &recordingHook{name: "2", calls: &calls}
+		providers, mocks := createMockProviders(ctrl, 2)
+		mocks["0"].EXPECT().Hooks().Return([]of.Hook{hook1})
+		mocks["0"].EXPECT().Metadata().Return(of.Metadata{Name: "mock"})
+		mocks["0"].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.BoolResolutionDetail{
+			ProviderResolutionDetail: of.ProviderResolutionDetail{ResolutionError: of.NewFlagNotFoundResolutionError("not found")},
+		})
+		mocks["1"].EXPECT().Hooks().Return([]of.Hook{hook2})
+		mocks["1"].EXPECT().Metadata().Return(of.Metadata{Name: "mock"})
+		mocks["1"].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.BoolResolutionDetail{Value: true})
+
+		strategy := NewFirstMatchStrategy(NewHookedProviders(providers))
+		result := strategy.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+		assert.True(t, result.Value)
+		assert.Equal(t, "1", result.FlagMetadata[MetadataSuccessfulProviderName])
+		assert.Equal(t, []string{"1-before", "1-error", "1-finally", "2-before", "2-after", "2-finally"}, calls)
+	})
+}
@@ -0,0 +1,241 @@
+package strategies
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+)
+
+type (
+	// Observation The outcome of the evaluation of a single internal provider
+	Observation struct {
+		// Strategy The strategy the provider was evaluated by
+		Strategy EvaluationStrategy
+		// ProviderName The name of the provider, `fallback` for the fallback provider of the ComparisonStrategy
+		ProviderName string
+		FlagKey      string
+		// Duration How long the evaluation of the provider took, including its hooks
+		Duration time.Duration
+		// ErrorCode The error code returned by the provider, empty if it succeeded
+		ErrorCode of.ErrorCode
+		// TimedOut Whether the provider failed after the deadline of the evaluation had passed
+		TimedOut bool
+		// Selected Whether the result of the provider was returned by the strategy
+		Selected bool
+	}
+
+	// Observer Receives an Observation for every evaluation of an internal provider. Observers are called synchronously
+	// by the evaluation, or by the background evaluations of the ShadowStrategy, so they should return quickly and must
+	// be safe for concurrent use.
+	Observer interface {
+		Observe(ctx context.Context, o Observation)
+	}
+
+	// ObserverFunc Adapter allowing a function to be used as an Observer
+	ObserverFunc func(ctx context.Context, o Observation)
+
+	// observedProvider wraps a provider and records the outcome of each of its evaluations with the observationCollector
+	// of the context
+	observedProvider struct {
+		of.FeatureProvider
+		name string
+	}
+
+	// observedStrategy wraps a strategy and reports the observations recorded during each evaluation to the observer
+	observedStrategy struct {
+		Strategy
+		observer Observer
+	}
+
+	// observationCollector collects the observations of a single evaluation until the strategy has selected its result.
+	// Observations recorded afterwards, e.g. by shadow providers or providers that did not respond in time, are reported
+	// immediately as not selected.
+	observationCollector struct {
+		mu           sync.Mutex
+		strategy     EvaluationStrategy
+		observer     Observer
+		observations []Observation
+		done         bool
+	}
+
+	observationCollectorKey struct{}
+)
+
+var (
+	_ Observer           = ObserverFunc(nil)
+	_ Strategy           = (*observedStrategy)(nil)
+	_ of.FeatureProvider = (*observedProvider)(nil)
+)
+
+// Observe Calls the function
+func (f ObserverFunc) Observe(ctx context.Context, o Observation) {
+	f(ctx, o)
+}
+
+// NewObservedProvider Wraps a provider so that the outcome of each of its evaluations is reported under the given name
+// to the Observer of a strategy created via NewObservedStrategy. Evaluations outside such a strategy are not reported.
+func NewObservedProvider(name string, provider of.FeatureProvider) of.FeatureProvider {
+	if _, ok := provider.(*observedProvider); ok || provider == nil {
+		return provider
+	}
+	return &observedProvider{FeatureProvider: provider, name: name}
+}
+
+// NewObservedProviders Wraps each provider using NewObservedProvider, retaining the names, circuit breakers and order of
+// the providers
+func NewObservedProviders(providers []*NamedProvider) []*NamedProvider {
+	observed := make([]*NamedProvider, 0, len(providers))
+	for _, p := range providers {
+		observed = append(observed, &NamedProvider{Name: p.Name, Provider: NewObservedProvider(p.Name, p.Provider), CircuitBreaker: p.CircuitBreaker})
+	}
+	return observed
+}
+
+// NewObservedStrategy Wraps a strategy so that the observer receives an Observation for every evaluation of an internal
+// provider. The providers passed to the strategy must be wrapped using NewObservedProviders. A provider is selected if
+// the strategy returned a result without an error and lists the provider as the successful provider in the flag
+// metadata.
+func NewObservedStrategy(strategy Strategy, observer Observer) Strategy {
+	if observer == nil {
+		return strategy
+	}
+	return &observedStrategy{Strategy: strategy, observer: observer}
+}
+
+func (s *observedStrategy) BooleanEvaluation(ctx context.Context, flag string, defaultValue bool, evalCtx of.FlattenedContext) of.BoolResolutionDetail {
+	ctx, c := s.collect(ctx)
+	result := s.Strategy.BooleanEvaluation(ctx, flag, defaultValue, evalCtx)
+	c.report(ctx, result.ProviderResolutionDetail)
+	return result
+}
+
+func (s *observedStrategy) StringEvaluation(ctx context.Context, flag string, defaultValue string, evalCtx of.FlattenedContext) of.StringResolutionDetail {
+	ctx, c := s.collect(ctx)
+	result := s.Strategy.StringEvaluation(ctx, flag, defaultValue, evalCtx)
+	c.report(ctx, result.ProviderResolutionDetail)
+	return result
+}
+
+func (s *observedStrategy) FloatEvaluation(ctx context.Context, flag string, defaultValue float64, evalCtx of.FlattenedContext) of.FloatResolutionDetail {
+	ctx, c := s.collect(ctx)
+	result := s.Strategy.FloatEvaluation(ctx, flag, defaultValue, evalCtx)
+	c.report(ctx, result.ProviderResolutionDetail)
+	return result
+}
+
+func (s *observedStrategy) IntEvaluation(ctx context.Context, flag string, defaultValue int64, evalCtx of.FlattenedContext) of.IntResolutionDetail {
+	ctx, c := s.collect(ctx)
+	result := s.Strategy.IntEvaluation(ctx, flag, defaultValue, evalCtx)
+	c.report(ctx, result.ProviderResolutionDetail)
+	return result
+}
+
+func (s *observedStrategy) ObjectEvaluation(ctx context.Context, flag string, defaultValue interface{}, evalCtx of.FlattenedContext) of.InterfaceResolutionDetail {
+	ctx, c := s.collect(ctx)
+	result := s.Strategy.ObjectEvaluation(ctx, flag, defaultValue, evalCtx)
+	c.report(ctx, result.ProviderResolutionDetail)
+	return result
+}
+
+// collect attaches a new observationCollector to the context
+func (s *observedStrategy) collect(ctx context.Context) (context.Context, *observationCollector) {
+	c := &observationCollector{strategy: s.Name(), observer: s.observer}
+	return context.WithValue(ctx, observationCollectorKey{}, c), c
+}
+
+// record records the observation, or reports it right away if the strategy already selected its result
+func (c *observationCollector) record(ctx context.Context, o Observation) {
+	o.Strategy = c.strategy
+	c.mu.Lock()
+	if !c.done {
+		c.observations = append(c.observations, o)
+		c.mu.Unlock()
+		return
+	}
+	c.mu.Unlock()
+	c.observer.Observe(ctx, o)
+}
+
+// report reports the recorded observations, marking the providers listed as successful in the result as selected
+func (c *observationCollector) report(ctx context.Context, detail of.ProviderResolutionDetail) {
+	c.mu.Lock()
+	c.done = true
+	observations := c.observations
+	c.observations = nil
+	c.mu.Unlock()
+
+	selected := selectedProviders(detail)
+	for _, o := range observations {
+		_, o.Selected = selected[o.ProviderName]
+		c.observer.Observe(ctx, o)
+	}
+}
+
+// selectedProviders the names of the providers whose result was returned by the strategy
+func selectedProviders(detail of.ProviderResolutionDetail) map[string]struct{} {
+	selected := make(map[string]struct{})
+	if detail.Error() != nil {
+		return selected
+	}
+	if names, ok := detail.FlagMetadata[MetadataSuccessfulProviderName+"s"].(string); ok && names != "" {
+		for _, name := range strings.Split(names, ", ") {
+			selected[name] = struct{}{}
+		}
+		return selected
+	}
+	if name, ok := detail.FlagMetadata[MetadataSuccessfulProviderName].(string); ok {
+		selected[name] = struct{}{}
+	}
+	return selected
+}
+
+func (p *observedProvider) BooleanEvaluation(ctx context.Context, flag string, defaultValue bool, evalCtx of.FlattenedContext) of.BoolResolutionDetail {
+	return evaluateObserved(ctx, p.name, flag, defaultValue, evalCtx, p.FeatureProvider.BooleanEvaluation)
+}
+
+func (p *observedProvider) StringEvaluation(ctx context.Context, flag string, defaultValue string, evalCtx of.FlattenedContext) of.StringResolutionDetail {
+	return evaluateObserved(ctx, p.name, flag, defaultValue, evalCtx, p.FeatureProvider.StringEvaluation)
+}
+
+func (p *observedProvider) FloatEvaluation(ctx context.Context, flag string, defaultValue float64, evalCtx of.FlattenedContext) of.FloatResolutionDetail {
+	return evaluateObserved(ctx, p.name, flag, defaultValue, evalCtx, p.FeatureProvider.FloatEvaluation)
+}
+
+func (p *observedProvider) IntEvaluation(ctx context.Context, flag string, defaultValue int64, evalCtx of.FlattenedContext) of.IntResolutionDetail {
+	return evaluateObserved(ctx, p.name, flag, defaultValue, evalCtx, p.FeatureProvider.IntEvaluation)
+}
+
+func (p *observedProvider) ObjectEvaluation(ctx context.Context, flag string, defaultValue interface{}, evalCtx of.FlattenedContext) of.InterfaceResolutionDetail {
+	return evaluateObserved(ctx, p.name, flag, defaultValue, evalCtx, p.FeatureProvider.ObjectEvaluation)
+}
+
+// evaluateObserved Evaluates the provider and records the outcome with the observationCollector of the context, if any
+func evaluateObserved[T any](
+	ctx context.Context,
+	name string,
+	flag string,
+	defaultValue T,
+	evalCtx of.FlattenedContext,
+	evaluate func(context.Context, string, T, of.FlattenedContext) of.GenericResolutionDetail[T],
+) of.GenericResolutionDetail[T] {
+	c, ok := ctx.Value(observationCollectorKey{}).(*observationCollector)
+	if !ok {
+		return evaluate(ctx, flag, defaultValue, evalCtx)
+	}
+
+	start := time.Now()
+	result := evaluate(ctx, flag, defaultValue, evalCtx)
+	errorCode := result.ResolutionDetail().ErrorCode
+	c.record(ctx, Observation{
+		ProviderName: name,
+		FlagKey:      flag,
+		Duration:     time.Since(start),
+		ErrorCode:    errorCode,
+		TimedOut:     errorCode != "" && errors.Is(ctx.Err(), context.DeadlineExceeded),
+	})
+	return result
+}
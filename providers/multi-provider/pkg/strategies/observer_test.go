@@ -0,0 +1,164 @@
+package strategies
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// recordingObserver collects observations and signals each one on the channel, if set
+type recordingObserver struct {
+	mu           sync.Mutex
+	observations []Observation
+	signal       chan Observation
+}
+
+func (o *recordingObserver) Observe(_ context.Context, observation Observation) {
+	o.mu.Lock()
+	o.observations = append(o.observations, observation)
+	o.mu.Unlock()
+	if o.signal != nil {
+		o.signal <- observation
+	}
+}
+
+func (o *recordingObserver) byProvider() map[string]Observation {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	observations := make(map[string]Observation, len(o.observations))
+	for _, observation := range o.observations {
+		observations[observation.ProviderName] = observation
+	}
+	return observations
+}
+
+func Test_ObservedStrategy(t *testing.T) {
+	t.Run("first match reports every provider evaluated", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 3)
+		configureFirstSuccessProvider(mocks["0"], false, false, TestErrorNotFound, 0)
+		configureFirstSuccessProvider(mocks["1"], true, true, TestErrorNone, 5*time.Millisecond)
+
+		observer := &recordingObserver{}
+		strategy := NewObservedStrategy(NewFirstMatchStrategy(NewObservedProviders(providers)), observer)
+		result := strategy.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+		require.True(t, result.Value)
+
+		observations := observer.byProvider()
+		require.Len(t, observations, 2)
+		assert.Equal(t, Observation{
+			Strategy:     StrategyFirstMatch,
+			ProviderName: "0",
+			FlagKey:      "flag",
+			Duration:     observations["0"].Duration,
+			ErrorCode:    of.FlagNotFoundCode,
+		}, observations["0"])
+		assert.True(t, observations["1"].Selected)
+		assert.Empty(t, observations["1"].ErrorCode)
+		assert.GreaterOrEqual(t, observations["1"].Duration, 5*time.Millisecond)
+	})
+
+	t.Run("nothing is selected if the strategy fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 2)
+		configureFirstSuccessProvider(mocks["0"], false, false, TestErrorError, 0)
+		configureFirstSuccessProvider(mocks["1"], false, false, TestErrorError, 0)
+
+		observer := &recordingObserver{}
+		strategy := NewObservedStrategy(NewFirstSuccessStrategy(NewObservedProviders(providers), time.Second), observer)
+		result := strategy.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+		require.Error(t, result.Error())
+
+		observations := observer.byProvider()
+		require.Len(t, observations, 2)
+		for _, observation := range observations {
+			assert.Equal(t, of.GeneralCode, observation.ErrorCode)
+			assert.False(t, observation.Selected)
+			assert.False(t, observation.TimedOut)
+		}
+	})
+
+	t.Run("providers failing after the timeout are reported as timed out", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 2)
+		configureFirstSuccessProvider(mocks["0"], true, true, TestErrorNone, 0)
+		mocks["1"].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ string, defaultValue bool, _ of.FlattenedContext) of.BoolResolutionDetail {
+				<-ctx.Done()
+				return of.BoolResolutionDetail{
+					Value: defaultValue,
+					ProviderResolutionDetail: of.ProviderResolutionDetail{
+						ResolutionError: of.NewGeneralResolutionError(ctx.Err().Error()),
+						Reason:          of.ErrorReason,
+					},
+				}
+			})
+
+		observer := &recordingObserver{signal: make(chan Observation, 2)}
+		voting, err := NewVotingStrategy(NewObservedProviders(providers), 1, nil, 10*time.Millisecond)
+		require.NoError(t, err)
+		result := NewObservedStrategy(voting, observer).BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+		require.True(t, result.Value)
+
+		<-observer.signal
+		<-observer.signal
+		observations := observer.byProvider()
+		assert.True(t, observations["0"].Selected)
+		assert.True(t, observations["1"].TimedOut)
+		assert.False(t, observations["1"].Selected)
+	})
+
+	t.Run("shadow providers are reported in the background", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 2)
+		configureFirstSuccessProvider(mocks["0"], true, true, TestErrorNone, 0)
+		configureFirstSuccessProvider(mocks["1"], true, true, TestErrorNone, 10*time.Millisecond)
+
+		observer := &recordingObserver{signal: make(chan Observation, 2)}
+		observed := NewObservedProviders(providers)
+		strategy := NewObservedStrategy(NewShadowStrategy(observed[0], observed[1:], func(ShadowMismatch) {}, time.Second), observer)
+		strategy.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+
+		assert.Equal(t, "0", (<-observer.signal).ProviderName)
+		shadow := <-observer.signal
+		assert.Equal(t, "1", shadow.ProviderName)
+		assert.False(t, shadow.Selected)
+		assert.Equal(t, StrategyShadow, shadow.Strategy)
+	})
+
+	t.Run("comparison selects all agreeing providers", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 2)
+		configureComparisonProvider(mocks["0"], true, true, TestErrorNone)
+		configureComparisonProvider(mocks["1"], true, true, TestErrorNone)
+
+		observer := &recordingObserver{}
+		strategy := NewObservedStrategy(NewComparisonStrategy(NewObservedProviders(providers), nil), observer)
+		strategy.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+
+		observations := observer.byProvider()
+		assert.True(t, observations["0"].Selected)
+		assert.True(t, observations["1"].Selected)
+	})
+
+	t.Run("providers evaluated outside an observed strategy are not reported", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 1)
+		configureFirstSuccessProvider(mocks["0"], true, true, TestErrorNone, 0)
+
+		provider := NewObservedProvider("0", providers[0].Provider)
+		assert.Same(t, provider, NewObservedProvider("other", provider))
+		assert.True(t, provider.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{}).Value)
+	})
+
+	t.Run("nil observer returns the strategy", func(t *testing.T) {
+		strategy := NewFirstMatchStrategy(nil)
+		assert.Same(t, Strategy(strategy), NewObservedStrategy(strategy, nil))
+	})
+}
@@ -0,0 +1,127 @@
+package strategies
+
+import (
+	"context"
+	"maps"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+)
+
+const (
+	// MetadataRoutingRule Flag metadata key holding the routing rule which selected the provider
+	MetadataRoutingRule = "multiprovider-routing-rule"
+)
+
+type (
+	// router Selects the provider to route an evaluation to. The returned rule describes the routing decision. If no
+	// provider is returned the resolution error is used as the result of the evaluation.
+	router func(flag string, evalCtx of.FlattenedContext) (provider *NamedProvider, rule string, err of.ResolutionError)
+
+	// routingStrategy Implements the type specific evaluation methods for strategies routing each evaluation to exactly
+	// one provider
+	routingStrategy struct {
+		name  EvaluationStrategy
+		route router
+	}
+)
+
+func (r *routingStrategy) Name() EvaluationStrategy {
+	return r.name
+}
+
+func (r *routingStrategy) BooleanEvaluation(ctx context.Context, flag string, defaultValue bool, evalCtx of.FlattenedContext) of.BoolResolutionDetail {
+	evalFunc := func(c context.Context, p *NamedProvider) resultWrapper[of.BoolResolutionDetail] {
+		result := p.Provider.BooleanEvaluation(c, flag, defaultValue, evalCtx)
+		return resultWrapper[of.BoolResolutionDetail]{
+			result: &result,
+			name:   p.Name,
+			value:  result.Value,
+			detail: result.ProviderResolutionDetail,
+		}
+	}
+	result := evaluateRouted(ctx, r, flag, evalCtx, evalFunc, defaultValue)
+	result.result.ProviderResolutionDetail = result.detail
+	return *result.result
+}
+
+func (r *routingStrategy) StringEvaluation(ctx context.Context, flag string, defaultValue string, evalCtx of.FlattenedContext) of.StringResolutionDetail {
+	evalFunc := func(c context.Context, p *NamedProvider) resultWrapper[of.StringResolutionDetail] {
+		result := p.Provider.StringEvaluation(c, flag, defaultValue, evalCtx)
+		return resultWrapper[of.StringResolutionDetail]{
+			result: &result,
+			name:   p.Name,
+			value:  result.Value,
+			detail: result.ProviderResolutionDetail,
+		}
+	}
+	result := evaluateRouted(ctx, r, flag, evalCtx, evalFunc, defaultValue)
+	result.result.ProviderResolutionDetail = result.detail
+	return *result.result
+}
+
+func (r *routingStrategy) FloatEvaluation(ctx context.Context, flag string, defaultValue float64, evalCtx of.FlattenedContext) of.FloatResolutionDetail {
+	evalFunc := func(c context.Context, p *NamedProvider) resultWrapper[of.FloatResolutionDetail] {
+		result := p.Provider.FloatEvaluation(c, flag, defaultValue, evalCtx)
+		return resultWrapper[of.FloatResolutionDetail]{
+			result: &result,
+			name:   p.Name,
+			value:  result.Value,
+			detail: result.ProviderResolutionDetail,
+		}
+	}
+	result := evaluateRouted(ctx, r, flag, evalCtx, evalFunc, defaultValue)
+	result.result.ProviderResolutionDetail = result.detail
+	return *result.result
+}
+
+func (r *routingStrategy) IntEvaluation(ctx context.Context, flag string, defaultValue int64, evalCtx of.FlattenedContext) of.IntResolutionDetail {
+	evalFunc := func(c context.Context, p *NamedProvider) resultWrapper[of.IntResolutionDetail] {
+		result := p.Provider.IntEvaluation(c, flag, defaultValue, evalCtx)
+		return resultWrapper[of.IntResolutionDetail]{
+			result: &result,
+			name:   p.Name,
+			value:  result.Value,
+			detail: result.ProviderResolutionDetail,
+		}
+	}
+	result := evaluateRouted(ctx, r, flag, evalCtx, evalFunc, defaultValue)
+	result.result.ProviderResolutionDetail = result.detail
+	return *result.result
+}
+
+func (r *routingStrategy) ObjectEvaluation(ctx context.Context, flag string, defaultValue interface{}, evalCtx of.FlattenedContext) of.InterfaceResolutionDetail {
+	evalFunc := func(c context.Context, p *NamedProvider) resultWrapper[of.InterfaceResolutionDetail] {
+		result := p.Provider.ObjectEvaluation(c, flag, defaultValue, evalCtx)
+		return resultWrapper[of.InterfaceResolutionDetail]{
+			result: &result,
+			name:   p.Name,
+			value:  result.Value,
+			detail: result.ProviderResolutionDetail,
+		}
+	}
+	result := evaluateRouted(ctx, r, flag, evalCtx, evalFunc, defaultValue)
+	result.result.ProviderResolutionDetail = result.detail
+	return *result.result
+}
+
+// evaluateRouted Evaluates the flag using the provider selected by the router. The routing decision is recorded in the
+// flag metadata.
+func evaluateRouted[R resultConstraint, DV bool | string | int64 | float64 | interface{}](ctx context.Context, r *routingStrategy, flag string, evalCtx of.FlattenedContext, e evaluator[R], defaultVal DV) resultWrapper[R] {
+	provider, rule, rErr := r.route(flag, evalCtx)
+	if provider == nil {
+		result := buildDefaultResult[R](r.name, defaultVal, nil)
+		result.detail.ResolutionError = rErr
+		if result.detail.ResolutionDetail().ErrorCode != of.FlagNotFoundCode {
+			result.detail.Reason = of.ErrorReason
+		}
+		if rule != "" {
+			result.detail.FlagMetadata[MetadataRoutingRule] = rule
+		}
+		return result
+	}
+
+	result := e(ctx, provider)
+	result.detail.FlagMetadata = setFlagMetadata(r.name, provider.Name, maps.Clone(result.detail.FlagMetadata))
+	result.detail.FlagMetadata[MetadataRoutingRule] = rule
+	return result
+}
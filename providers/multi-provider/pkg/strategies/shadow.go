@@ -0,0 +1,244 @@
+package strategies
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"sync"
+	"time"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+)
+
+type (
+	// ShadowStrategy always returns the result of the primary provider. The shadow providers are evaluated in the
+	// background after the primary result has been returned, and any difference is reported as a ShadowMismatch.
+	ShadowStrategy struct {
+		primary    *NamedProvider
+		shadows    []*NamedProvider
+		onMismatch ShadowMismatchHandler
+		timeout    time.Duration
+		inFlight   chan struct{}
+	}
+
+	// ShadowOption Function used for configuring a ShadowStrategy
+	ShadowOption func(*ShadowStrategy)
+
+	// ShadowMismatch Structured record of a shadow provider disagreeing with the primary provider
+	ShadowMismatch struct {
+		FlagKey         string
+		PrimaryProvider string
+		ShadowProvider  string
+		PrimaryValue    any
+		ShadowValue     any
+		PrimaryVariant  string
+		ShadowVariant   string
+		PrimaryError    error
+		ShadowError     error
+	}
+
+	// ShadowMismatchHandler Callback invoked in the background for every ShadowMismatch
+	ShadowMismatchHandler func(mismatch ShadowMismatch)
+)
+
+// DefaultMaxInFlightShadows Default limit of the shadow evaluations running in the background at the same time
+const DefaultMaxInFlightShadows = 100
+
+var _ Strategy = (*ShadowStrategy)(nil)
+
+// WithMaxInFlightShadows Limits the shadow evaluations running in the background at the same time, each shadow provider
+// evaluating a flag counting as one. Shadow evaluations exceeding the limit are dropped and logged, so slow shadow
+// providers cannot pile up goroutines. A limit below 1 keeps the default of DefaultMaxInFlightShadows.
+func WithMaxInFlightShadows(limit int) ShadowOption {
+	return func(s *ShadowStrategy) {
+		if limit > 0 {
+			s.inFlight = make(chan struct{}, limit)
+		}
+	}
+}
+
+// NewShadowStrategy Creates a new ShadowStrategy instance. If onMismatch is nil mismatches are logged as structured
+// records using the default slog logger. The timeout limits the background evaluation of the shadow providers.
+func NewShadowStrategy(primary *NamedProvider, shadows []*NamedProvider, onMismatch ShadowMismatchHandler, timeout time.Duration, opts ...ShadowOption) *ShadowStrategy {
+	if onMismatch == nil {
+		onMismatch = logShadowMismatch
+	}
+	s := &ShadowStrategy{
+		primary:    primary,
+		shadows:    shadows,
+		onMismatch: onMismatch,
+		timeout:    timeout,
+		inFlight:   make(chan struct{}, DefaultMaxInFlightShadows),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *ShadowStrategy) Name() EvaluationStrategy {
+	return StrategyShadow
+}
+
+func (s *ShadowStrategy) BooleanEvaluation(ctx context.Context, flag string, defaultValue bool, evalCtx of.FlattenedContext) of.BoolResolutionDetail {
+	evalFunc := func(c context.Context, p *NamedProvider) resultWrapper[of.BoolResolutionDetail] {
+		result := p.Provider.BooleanEvaluation(c, flag, defaultValue, evalCtx)
+		return resultWrapper[of.BoolResolutionDetail]{
+			result: &result,
+			name:   p.Name,
+			value:  result.Value,
+			detail: result.ProviderResolutionDetail,
+		}
+	}
+	result := evaluateShadow(ctx, s, flag, evalFunc)
+	result.result.ProviderResolutionDetail = result.detail
+	return *result.result
+}
+
+func (s *ShadowStrategy) StringEvaluation(ctx context.Context, flag string, defaultValue string, evalCtx of.FlattenedContext) of.StringResolutionDetail {
+	evalFunc := func(c context.Context, p *NamedProvider) resultWrapper[of.StringResolutionDetail] {
+		result := p.Provider.StringEvaluation(c, flag, defaultValue, evalCtx)
+		return resultWrapper[of.StringResolutionDetail]{
+			result: &result,
+			name:   p.Name,
+			value:  result.Value,
+			detail: result.ProviderResolutionDetail,
+		}
+	}
+	result := evaluateShadow(ctx, s, flag, evalFunc)
+	result.result.ProviderResolutionDetail = result.detail
+	return *result.result
+}
+
+func (s *ShadowStrategy) FloatEvaluation(ctx context.Context, flag string, defaultValue float64, evalCtx of.FlattenedContext) of.FloatResolutionDetail {
+	evalFunc := func(c context.Context, p *NamedProvider) resultWrapper[of.FloatResolutionDetail] {
+		result := p.Provider.FloatEvaluation(c, flag, defaultValue, evalCtx)
+		return resultWrapper[of.FloatResolutionDetail]{
+			result: &result,
+			name:   p.Name,
+			value:  result.Value,
+			detail: result.ProviderResolutionDetail,
+		}
+	}
+	result := evaluateShadow(ctx, s, flag, evalFunc)
+	result.result.ProviderResolutionDetail = result.detail
+	return *result.result
+}
+
+func (s *ShadowStrategy) IntEvaluation(ctx context.Context, flag string, defaultValue int64, evalCtx of.FlattenedContext) of.IntResolutionDetail {
+	evalFunc := func(c context.Context, p *NamedProvider) resultWrapper[of.IntResolutionDetail] {
+		result := p.Provider.IntEvaluation(c, flag, defaultValue, evalCtx)
+		return resultWrapper[of.IntResolutionDetail]{
+			result: &result,
+			name:   p.Name,
+			value:  result.Value,
+			detail: result.ProviderResolutionDetail,
+		}
+	}
+	result := evaluateShadow(ctx, s, flag, evalFunc)
+	result.result.ProviderResolutionDetail = result.detail
+	return *result.result
+}
+
+func (s *ShadowStrategy) ObjectEvaluation(ctx context.Context, flag string, defaultValue interface{}, evalCtx of.FlattenedContext) of.InterfaceResolutionDetail {
+	evalFunc := func(c context.Context, p *NamedProvider) resultWrapper[of.InterfaceResolutionDetail] {
+		result := p.Provider.ObjectEvaluation(c, flag, defaultValue, evalCtx)
+		return resultWrapper[of.InterfaceResolutionDetail]{
+			result: &result,
+			name:   p.Name,
+			value:  result.Value,
+			detail: result.ProviderResolutionDetail,
+		}
+	}
+	result := evaluateShadow(ctx, s, flag, evalFunc)
+	result.result.ProviderResolutionDetail = result.detail
+	return *result.result
+}
+
+// evaluateShadow Evaluates the primary provider and returns its result. The shadow providers are evaluated in the
+// background using a context detached from the caller's cancellation, so they never add latency to the caller. Shadow
+// evaluations exceeding the in-flight limit are dropped.
+func evaluateShadow[R resultConstraint](ctx context.Context, s *ShadowStrategy, flag string, e evaluator[R]) resultWrapper[R] {
+	primary := e(ctx, s.primary)
+	primary.detail.FlagMetadata = setFlagMetadata(StrategyShadow, s.primary.Name, maps.Clone(primary.detail.FlagMetadata))
+
+	if len(s.shadows) == 0 {
+		return primary
+	}
+
+	shadowCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.timeout)
+	var wg sync.WaitGroup
+	for _, shadow := range s.shadows {
+		select {
+		case s.inFlight <- struct{}{}:
+		default:
+			logDroppedShadow(flag, shadow.Name, cap(s.inFlight))
+			continue
+		}
+		wg.Go(func() {
+			defer func() { <-s.inFlight }()
+			result := e(shadowCtx, shadow)
+			if mismatch, ok := compareShadowResult(flag, primary, result); ok {
+				s.onMismatch(mismatch)
+			}
+		})
+	}
+	go func() {
+		wg.Wait()
+		cancel()
+	}()
+
+	return primary
+}
+
+// compareShadowResult Compares the result of a shadow provider against the primary result. Values & variants are only
+// compared if both providers succeeded, otherwise a mismatch is reported if exactly one of them failed.
+func compareShadowResult[R resultConstraint](flag string, primary resultWrapper[R], shadow resultWrapper[R]) (ShadowMismatch, bool) {
+	primaryErr := primary.detail.Error()
+	shadowErr := shadow.detail.Error()
+	mismatch := ShadowMismatch{
+		FlagKey:         flag,
+		PrimaryProvider: primary.name,
+		ShadowProvider:  shadow.name,
+		PrimaryValue:    primary.value,
+		ShadowValue:     shadow.value,
+		PrimaryVariant:  primary.detail.Variant,
+		ShadowVariant:   shadow.detail.Variant,
+		PrimaryError:    primaryErr,
+		ShadowError:     shadowErr,
+	}
+
+	switch {
+	case primaryErr != nil && shadowErr != nil:
+		return mismatch, false
+	case primaryErr != nil || shadowErr != nil:
+		return mismatch, true
+	default:
+		equal := primary.detail.Variant == shadow.detail.Variant && JSONEqual(primary.value, shadow.value)
+		return mismatch, !equal
+	}
+}
+
+// logShadowMismatch Default ShadowMismatchHandler emitting a structured log record
+func logShadowMismatch(m ShadowMismatch) {
+	slog.Default().LogAttrs(context.Background(), slog.LevelWarn, "shadow provider result mismatch",
+		slog.String("flag-key", m.FlagKey),
+		slog.String("primary-provider", m.PrimaryProvider),
+		slog.String("shadow-provider", m.ShadowProvider),
+		slog.Any("primary-value", m.PrimaryValue),
+		slog.Any("shadow-value", m.ShadowValue),
+		slog.String("primary-variant", m.PrimaryVariant),
+		slog.String("shadow-variant", m.ShadowVariant),
+		slog.Any("primary-error", m.PrimaryError),
+		slog.Any("shadow-error", m.ShadowError),
+	)
+}
+
+// logDroppedShadow Reports a shadow evaluation dropped because the limit of in-flight shadow evaluations was reached
+func logDroppedShadow(flag string, shadowProvider string, limit int) {
+	slog.Default().LogAttrs(context.Background(), slog.LevelWarn, "shadow evaluation dropped, too many shadow evaluations in flight",
+		slog.String("flag-key", flag),
+		slog.String("shadow-provider", shadowProvider),
+		slog.Int("limit", limit),
+	)
+}
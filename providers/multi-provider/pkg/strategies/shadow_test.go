@@ -0,0 +1,170 @@
+package strategies
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_ShadowStrategy(t *testing.T) {
+	t.Run("returns the primary result", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 2)
+		mocks["0"].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.BoolResolutionDetail{
+			Value:                    true,
+			ProviderResolutionDetail: of.ProviderResolutionDetail{Variant: "on"},
+		})
+		shadowDone := make(chan struct{})
+		mocks["1"].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(context.Context, string, bool, of.FlattenedContext) of.BoolResolutionDetail {
+				defer close(shadowDone)
+				return of.BoolResolutionDetail{Value: true, ProviderResolutionDetail: of.ProviderResolutionDetail{Variant: "on"}}
+			})
+
+		strategy := NewShadowStrategy(providers[0], providers[1:], func(m ShadowMismatch) {
+			t.Errorf("unexpected mismatch: %+v", m)
+		}, time.Second)
+		assert.Equal(t, StrategyShadow, strategy.Name())
+		result := strategy.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+		assert.True(t, result.Value)
+		assert.Equal(t, "0", result.FlagMetadata[MetadataSuccessfulProviderName])
+		assert.Equal(t, StrategyShadow, result.FlagMetadata[MetadataStrategyUsed])
+		<-shadowDone
+	})
+
+	t.Run("slow shadow does not block the caller", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 2)
+		mocks["0"].EXPECT().StringEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.StringResolutionDetail{Value: "primary"})
+		release := make(chan struct{})
+		mocks["1"].EXPECT().StringEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(context.Context, string, string, of.FlattenedContext) of.StringResolutionDetail {
+				<-release
+				return of.StringResolutionDetail{Value: "shadow"}
+			})
+		mismatches := make(chan ShadowMismatch, 1)
+
+		strategy := NewShadowStrategy(providers[0], providers[1:], func(m ShadowMismatch) { mismatches <- m }, time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
+		result := strategy.StringEvaluation(ctx, "flag", "default", of.FlattenedContext{})
+		cancel()
+		assert.Equal(t, "primary", result.Value)
+
+		close(release)
+		select {
+		case m := <-mismatches:
+			assert.Equal(t, "flag", m.FlagKey)
+			assert.Equal(t, "0", m.PrimaryProvider)
+			assert.Equal(t, "1", m.ShadowProvider)
+			assert.Equal(t, "primary", m.PrimaryValue)
+			assert.Equal(t, "shadow", m.ShadowValue)
+		case <-time.After(time.Second):
+			require.Fail(t, "mismatch not reported")
+		}
+	})
+
+	t.Run("variant mismatch is reported", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 2)
+		mocks["0"].EXPECT().IntEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.IntResolutionDetail{
+			Value:                    1,
+			ProviderResolutionDetail: of.ProviderResolutionDetail{Variant: "a"},
+		})
+		mocks["1"].EXPECT().IntEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.IntResolutionDetail{
+			Value:                    1,
+			ProviderResolutionDetail: of.ProviderResolutionDetail{Variant: "b"},
+		})
+		mismatches := make(chan ShadowMismatch, 1)
+
+		strategy := NewShadowStrategy(providers[0], providers[1:], func(m ShadowMismatch) { mismatches <- m }, time.Second)
+		result := strategy.IntEvaluation(context.Background(), "flag", 0, of.FlattenedContext{})
+		assert.Equal(t, int64(1), result.Value)
+		m := <-mismatches
+		assert.Equal(t, "a", m.PrimaryVariant)
+		assert.Equal(t, "b", m.ShadowVariant)
+	})
+
+	t.Run("shadow error is reported", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 3)
+		mocks["0"].EXPECT().ObjectEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.InterfaceResolutionDetail{
+			Value: map[string]any{"key": "value"},
+		})
+		mocks["1"].EXPECT().ObjectEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.InterfaceResolutionDetail{
+			Value: map[string]any{"key": "value"},
+		})
+		mocks["2"].EXPECT().ObjectEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.InterfaceResolutionDetail{
+			ProviderResolutionDetail: of.ProviderResolutionDetail{ResolutionError: of.NewFlagNotFoundResolutionError("not found")},
+		})
+		mismatches := make(chan ShadowMismatch, 2)
+
+		strategy := NewShadowStrategy(providers[0], providers[1:], func(m ShadowMismatch) { mismatches <- m }, time.Second)
+		result := strategy.ObjectEvaluation(context.Background(), "flag", nil, of.FlattenedContext{})
+		assert.Equal(t, map[string]any{"key": "value"}, result.Value)
+		m := <-mismatches
+		assert.Equal(t, "2", m.ShadowProvider)
+		assert.Nil(t, m.PrimaryError)
+		require.Error(t, m.ShadowError)
+		select {
+		case m := <-mismatches:
+			assert.Failf(t, "unexpected mismatch", "%+v", m)
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+
+	t.Run("both failing is not a mismatch", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 2)
+		shadowDone := make(chan struct{})
+		notFound := of.FloatResolutionDetail{
+			ProviderResolutionDetail: of.ProviderResolutionDetail{ResolutionError: of.NewFlagNotFoundResolutionError("not found")},
+		}
+		mocks["0"].EXPECT().FloatEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound)
+		mocks["1"].EXPECT().FloatEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(context.Context, string, float64, of.FlattenedContext) of.FloatResolutionDetail {
+				defer close(shadowDone)
+				return notFound
+			})
+
+		strategy := NewShadowStrategy(providers[0], providers[1:], func(m ShadowMismatch) {
+			t.Errorf("unexpected mismatch: %+v", m)
+		}, time.Second)
+		result := strategy.FloatEvaluation(context.Background(), "flag", 1.5, of.FlattenedContext{})
+		assert.Equal(t, of.FlagNotFoundCode, result.ResolutionDetail().ErrorCode)
+		<-shadowDone
+	})
+
+	t.Run("shadow evaluations beyond the in-flight limit are dropped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 2)
+		mocks["0"].EXPECT().StringEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(of.StringResolutionDetail{Value: "primary"}).Times(3)
+		release := make(chan struct{})
+		shadowDone := make(chan struct{}, 2)
+		mocks["1"].EXPECT().StringEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(context.Context, string, string, of.FlattenedContext) of.StringResolutionDetail {
+				<-release
+				shadowDone <- struct{}{}
+				return of.StringResolutionDetail{Value: "primary"}
+			}).Times(2)
+
+		strategy := NewShadowStrategy(providers[0], providers[1:], func(m ShadowMismatch) {
+			t.Errorf("unexpected mismatch: %+v", m)
+		}, time.Second, WithMaxInFlightShadows(1))
+		// the second evaluation finds the only slot taken by the blocked shadow of the first one
+		assert.Equal(t, "primary", strategy.StringEvaluation(context.Background(), "flag", "default", of.FlattenedContext{}).Value)
+		assert.Equal(t, "primary", strategy.StringEvaluation(context.Background(), "flag", "default", of.FlattenedContext{}).Value)
+		close(release)
+		<-shadowDone
+
+		// the slot is released once the shadow evaluation completed
+		require.Eventually(t, func() bool { return len(strategy.inFlight) == 0 }, time.Second, time.Millisecond)
+		assert.Equal(t, "primary", strategy.StringEvaluation(context.Background(), "flag", "default", of.FlattenedContext{}).Value)
+		<-shadowDone
+	})
+}
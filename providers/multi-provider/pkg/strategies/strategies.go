@@ -4,10 +4,14 @@
 package strategies
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"regexp"
+	"slices"
 	"strings"
 
+	mperr "github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg/errors"
 	of "github.com/open-feature/go-sdk/openfeature"
 )
 
@@ -18,9 +22,25 @@ const (
 	StrategyFirstMatch                       = "strategy-first-match"
 	StrategyFirstSuccess                     = "strategy-first-success"
 	StrategyComparison                       = "strategy-comparison"
+	StrategyShadow                           = "strategy-shadow"
+	StrategyFlagKeyRouting                   = "strategy-flag-key-routing"
+	StrategyContextRouting                   = "strategy-context-routing"
+	StrategyVoting                           = "strategy-voting"
 	ReasonAggregated               of.Reason = "AGGREGATED"
 	ReasonAggregatedFallback       of.Reason = "AGGREGATED_FALLBACK"
-	ErrAggregationNotAllowedText             = "object evaluation not allowed for non-comparable types"
+	// Deprecated: object flags are compared structurally by the ComparisonStrategy, this error is no longer returned
+	ErrAggregationNotAllowedText = "object evaluation not allowed for non-comparable types"
+)
+
+const (
+	// MetadataProviderErrors The resolution errors of the providers that failed to resolve the flag, including the ones
+	// not finding it. Set as an of.FlagMetadata keyed by provider name, holding an of.FlagMetadata with the
+	// MetadataErrorCode & MetadataErrorMessage of each provider.
+	MetadataProviderErrors = "multiprovider-provider-errors"
+	// MetadataErrorCode The original error code of a provider within MetadataProviderErrors
+	MetadataErrorCode = "error-code"
+	// MetadataErrorMessage The original error message of a provider within MetadataProviderErrors
+	MetadataErrorMessage = "error-message"
 )
 
 type (
@@ -41,6 +61,9 @@ type (
 	NamedProvider struct {
 		Name     string
 		Provider of.FeatureProvider
+		// CircuitBreaker Optional circuit breaker used by the FirstMatch & FirstSuccess strategies to skip the provider
+		// while it is failing or slow
+		CircuitBreaker *CircuitBreaker
 	}
 
 	resultConstraint interface {
@@ -57,15 +80,21 @@ type (
 	evaluator[R resultConstraint] func(ctx context.Context, p *NamedProvider) resultWrapper[R]
 )
 
-// buildDefaultResult Creates a default result using reflection via generics
+// buildDefaultResult Creates a default result using reflection via generics. The errors of an mperr.AggregateError are
+// kept in the MetadataProviderErrors, the result uses their error code if all providers failed with the same code.
 func buildDefaultResult[R resultConstraint, DV bool | string | int64 | float64 | interface{}](strategy EvaluationStrategy, defaultValue DV, err error) resultWrapper[R] {
 	result := new(R)
 	var rErr of.ResolutionError
 	var reason of.Reason
-	if err != nil {
+	var aggregate *mperr.AggregateError
+	switch {
+	case errors.As(err, &aggregate):
+		rErr = newResolutionError(aggregate.ErrorCode(), aggregate.ErrorMessage())
+		reason = of.ErrorReason
+	case err != nil:
 		rErr = of.NewGeneralResolutionError(cleanErrorMessage(err.Error()))
 		reason = of.ErrorReason
-	} else {
+	default:
 		rErr = of.NewFlagNotFoundResolutionError("not found in any provider")
 		reason = of.DefaultReason
 	}
@@ -74,6 +103,9 @@ func buildDefaultResult[R resultConstraint, DV bool | string | int64 | float64 |
 		Reason:          reason,
 		FlagMetadata:    of.FlagMetadata{MetadataSuccessfulProviderName: "none", MetadataStrategyUsed: strategy},
 	}
+	if aggregate != nil {
+		setProviderErrors(details.FlagMetadata, aggregate.Errors()...)
+	}
 	switch dv := any(defaultValue).(type) {
 	case bool:
 		r := any(result).(*of.BoolResolutionDetail)
@@ -109,6 +141,28 @@ func setFlagMetadata(strategyUsed EvaluationStrategy, successProviderName string
 	return metadata
 }
 
+// newResolutionError Creates a resolution error with the given code
+func newResolutionError(code of.ErrorCode, msg string) of.ResolutionError {
+	switch code {
+	case of.ProviderNotReadyCode:
+		return of.NewProviderNotReadyResolutionError(msg)
+	case of.ProviderFatalCode:
+		return of.NewProviderFatalResolutionError(msg)
+	case of.FlagNotFoundCode:
+		return of.NewFlagNotFoundResolutionError(msg)
+	case of.ParseErrorCode:
+		return of.NewParseErrorResolutionError(msg)
+	case of.TypeMismatchCode:
+		return of.NewTypeMismatchResolutionError(msg)
+	case of.TargetingKeyMissingCode:
+		return of.NewTargetingKeyMissingResolutionError(msg)
+	case of.InvalidContextCode:
+		return of.NewInvalidContextResolutionError(msg)
+	default:
+		return of.NewGeneralResolutionError(msg)
+	}
+}
+
 func cleanErrorMessage(msg string) string {
 	codeRegex := strings.Join([]string{
 		string(of.ProviderNotReadyCode),
@@ -130,6 +184,44 @@ func cleanErrorMessage(msg string) string {
 	}
 }
 
+// SortByProviderOrder Sorts the given items by the position of the provider they belong to within providers. Items of
+// unknown providers are sorted first, items of the same provider keep their relative order.
+func SortByProviderOrder[T any](providers []*NamedProvider, items []T, name func(T) string) {
+	order := make(map[string]int, len(providers))
+	for i, p := range providers {
+		order[p.Name] = i
+	}
+	slices.SortStableFunc(items, func(a, b T) int {
+		return cmp.Compare(order[name(a)], order[name(b)])
+	})
+}
+
+// setSkippedProviders Records the providers skipped because of an open circuit breaker in the metadata, if any
+func setSkippedProviders(metadata of.FlagMetadata, skipped []string) {
+	if len(skipped) > 0 {
+		metadata[MetadataSkippedProviders] = strings.Join(skipped, ", ")
+	}
+}
+
+// setProviderErrors Records the original error code & message of the providers in the MetadataProviderErrors of the
+// metadata, keeping the errors already recorded
+func setProviderErrors(metadata of.FlagMetadata, errs ...mperr.ProviderError) {
+	if len(errs) == 0 {
+		return
+	}
+	providerErrors, ok := metadata[MetadataProviderErrors].(of.FlagMetadata)
+	if !ok {
+		providerErrors = make(of.FlagMetadata, len(errs))
+		metadata[MetadataProviderErrors] = providerErrors
+	}
+	for _, err := range errs {
+		providerErrors[err.ProviderName] = of.FlagMetadata{
+			MetadataErrorCode:    string(err.ErrorCode()),
+			MetadataErrorMessage: err.ErrorMessage(),
+		}
+	}
+}
+
 // mergeFlagTags Merges flag metadata together into a single FlagMetadata instance by performing a shallow merge
 func mergeFlagTags(tags ...of.FlagMetadata) of.FlagMetadata {
 	size := len(tags)
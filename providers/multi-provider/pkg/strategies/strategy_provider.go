@@ -0,0 +1,186 @@
+package strategies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	mperr "github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg/errors"
+	of "github.com/open-feature/go-sdk/openfeature"
+)
+
+const (
+	// metadataKeyPrefix Prefix of all flag metadata keys set by the strategies
+	metadataKeyPrefix = "multiprovider-"
+	// metadataNamespaceSeparator Separates the namespaces of a nested flag metadata key
+	metadataNamespaceSeparator = "/"
+)
+
+// StrategyProvider Exposes a Strategy together with its providers as a single provider, so it can be used as a child of
+// another strategy, e.g. a ComparisonStrategy comparing a vendor against a FirstSuccessStrategy over multiple flagd
+// providers. The flag metadata keys set by the nested strategy are namespaced with the name of the StrategyProvider,
+// see NamespacedMetadataKey, so the path of a nested result can still be traced. Events of the providers are not
+// forwarded.
+type StrategyProvider struct {
+	name      string
+	strategy  Strategy
+	providers []*NamedProvider
+}
+
+var (
+	_ of.FeatureProvider = (*StrategyProvider)(nil)
+	_ of.StateHandler    = (*StrategyProvider)(nil)
+)
+
+// NewStrategyProvider Creates a new StrategyProvider over the providers, which are initialized & shut down together with
+// it. The nested strategy is created by newStrategy from the same providers, so both always match, e.g.
+//
+//	NewStrategyProvider("flagd", providers, func(p []*NamedProvider) (Strategy, error) {
+//		return NewFirstSuccessStrategy(NewHookedProviders(p), time.Second), nil
+//	})
+func NewStrategyProvider(name string, providers []*NamedProvider, newStrategy func(providers []*NamedProvider) (Strategy, error)) (*StrategyProvider, error) {
+	if len(providers) == 0 {
+		return nil, errors.New("at least one provider must be set")
+	}
+	if newStrategy == nil {
+		return nil, errors.New("strategy constructor cannot be nil")
+	}
+	providers = slices.Clone(providers)
+	strategy, err := newStrategy(slices.Clone(providers))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the strategy of %s: %w", name, err)
+	}
+	if strategy == nil {
+		return nil, fmt.Errorf("strategy constructor of %s returned nil", name)
+	}
+	return &StrategyProvider{
+		name:      name,
+		strategy:  strategy,
+		providers: providers,
+	}, nil
+}
+
+// NamespacedMetadataKey Returns the key under which a StrategyProvider with the given name exposes a flag metadata key
+// of its nested strategy, e.g. `flagd/multiprovider-successful-provider-name`. Keys of deeper nested strategies are
+// namespaced once per level, e.g. `outer/inner/multiprovider-strategy-used`.
+func NamespacedMetadataKey(namespace string, key string) string {
+	return namespace + metadataNamespaceSeparator + key
+}
+
+// Metadata The name of the StrategyProvider along with the strategy and the names of its providers
+func (s *StrategyProvider) Metadata() of.Metadata {
+	names := make([]string, 0, len(s.providers))
+	for _, p := range s.providers {
+		names = append(names, p.Name)
+	}
+	return of.Metadata{
+		Name: fmt.Sprintf("%s {%s: %s}", s.name, s.strategy.Name(), strings.Join(names, ", ")),
+	}
+}
+
+// Hooks The hooks of the providers are executed by the nested strategy, so none are returned
+func (s *StrategyProvider) Hooks() []of.Hook {
+	return []of.Hook{}
+}
+
+// Strategy The nested strategy
+func (s *StrategyProvider) Strategy() Strategy {
+	return s.strategy
+}
+
+// Providers Returns the providers of the nested strategy
+func (s *StrategyProvider) Providers() []*NamedProvider {
+	return slices.Clone(s.providers)
+}
+
+// Init Initializes all providers in parallel and aggregates the errors
+func (s *StrategyProvider) Init(evalCtx of.EvaluationContext) error {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	errs := make([]mperr.ProviderError, 0, len(s.providers))
+	for _, p := range s.providers {
+		stateHandle, ok := p.Provider.(of.StateHandler)
+		if !ok {
+			continue
+		}
+		wg.Go(func() {
+			if err := stateHandle.Init(evalCtx); err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				errs = append(errs, mperr.ProviderError{ProviderName: p.Name, Err: err})
+			}
+		})
+	}
+	wg.Wait()
+
+	if len(errs) == 0 {
+		return nil
+	}
+	SortByProviderOrder(s.providers, errs, func(e mperr.ProviderError) string { return e.ProviderName })
+	return mperr.NewAggregateError(errs)
+}
+
+// Shutdown Shuts down all providers in parallel
+func (s *StrategyProvider) Shutdown() {
+	var wg sync.WaitGroup
+	for _, p := range s.providers {
+		if stateHandle, ok := p.Provider.(of.StateHandler); ok {
+			wg.Go(stateHandle.Shutdown)
+		}
+	}
+	wg.Wait()
+}
+
+func (s *StrategyProvider) BooleanEvaluation(ctx context.Context, flag string, defaultValue bool, evalCtx of.FlattenedContext) of.BoolResolutionDetail {
+	result := s.strategy.BooleanEvaluation(ctx, flag, defaultValue, evalCtx)
+	result.FlagMetadata = s.namespaceMetadata(result.FlagMetadata)
+	return result
+}
+
+func (s *StrategyProvider) StringEvaluation(ctx context.Context, flag string, defaultValue string, evalCtx of.FlattenedContext) of.StringResolutionDetail {
+	result := s.strategy.StringEvaluation(ctx, flag, defaultValue, evalCtx)
+	result.FlagMetadata = s.namespaceMetadata(result.FlagMetadata)
+	return result
+}
+
+func (s *StrategyProvider) FloatEvaluation(ctx context.Context, flag string, defaultValue float64, evalCtx of.FlattenedContext) of.FloatResolutionDetail {
+	result := s.strategy.FloatEvaluation(ctx, flag, defaultValue, evalCtx)
+	result.FlagMetadata = s.namespaceMetadata(result.FlagMetadata)
+	return result
+}
+
+func (s *StrategyProvider) IntEvaluation(ctx context.Context, flag string, defaultValue int64, evalCtx of.FlattenedContext) of.IntResolutionDetail {
+	result := s.strategy.IntEvaluation(ctx, flag, defaultValue, evalCtx)
+	result.FlagMetadata = s.namespaceMetadata(result.FlagMetadata)
+	return result
+}
+
+func (s *StrategyProvider) ObjectEvaluation(ctx context.Context, flag string, defaultValue interface{}, evalCtx of.FlattenedContext) of.InterfaceResolutionDetail {
+	result := s.strategy.ObjectEvaluation(ctx, flag, defaultValue, evalCtx)
+	result.FlagMetadata = s.namespaceMetadata(result.FlagMetadata)
+	return result
+}
+
+// namespaceMetadata Namespaces the keys set by the nested strategy, including the ones already namespaced by deeper
+// nested strategies. All other keys, e.g. the ones set by the providers themselves, are kept as is.
+func (s *StrategyProvider) namespaceMetadata(metadata of.FlagMetadata) of.FlagMetadata {
+	namespaced := make(of.FlagMetadata, len(metadata))
+	for key, value := range metadata {
+		if isStrategyMetadataKey(key) {
+			key = NamespacedMetadataKey(s.name, key)
+		}
+		namespaced[key] = value
+	}
+	return namespaced
+}
+
+// isStrategyMetadataKey Reports whether the key was set by a strategy, possibly namespaced by a StrategyProvider
+func isStrategyMetadataKey(key string) bool {
+	if i := strings.LastIndex(key, metadataNamespaceSeparator); i >= 0 {
+		key = key[i+len(metadataNamespaceSeparator):]
+	}
+	return strings.HasPrefix(key, metadataKeyPrefix)
+}
@@ -0,0 +1,124 @@
+package strategies
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	m "github.com/open-feature/go-sdk-contrib/providers/multi-provider/internal/mocks"
+	of "github.com/open-feature/go-sdk/openfeature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newFirstMatch(providers []*NamedProvider) (Strategy, error) {
+	return NewFirstMatchStrategy(providers), nil
+}
+
+func Test_StrategyProvider(t *testing.T) {
+	t.Run("nested strategy as child of another strategy", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		flagd, mocks := createMockProviders(ctrl, 2)
+		configureFirstSuccessProvider(mocks["0"], false, false, TestErrorError, 0)
+		configureFirstSuccessProvider(mocks["1"], true, true, TestErrorNone, 0)
+		nested, err := NewStrategyProvider("flagd", flagd, func(p []*NamedProvider) (Strategy, error) {
+			return NewFirstSuccessStrategy(p, time.Second), nil
+		})
+		require.NoError(t, err)
+
+		vendor := m.NewMockFeatureProvider(ctrl)
+		configureComparisonProvider(vendor, true, true, TestErrorNone)
+
+		strategy := NewComparisonStrategy([]*NamedProvider{
+			{Name: "flagd", Provider: nested},
+			{Name: "vendor", Provider: vendor},
+		}, nil)
+		result := strategy.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+		require.NoError(t, result.Error())
+		assert.True(t, result.Value)
+		assert.Equal(t, StrategyComparison, result.FlagMetadata[MetadataStrategyUsed])
+		assert.Equal(t, "flagd, vendor", result.FlagMetadata[MetadataSuccessfulProviderName+"s"])
+
+		// the comparison strategy keeps the metadata of each provider under its name
+		flagdMetadata, ok := result.FlagMetadata["flagd"].(of.FlagMetadata)
+		require.True(t, ok)
+		assert.Equal(t, StrategyFirstSuccess, flagdMetadata[NamespacedMetadataKey("flagd", MetadataStrategyUsed)])
+		assert.Equal(t, "1", flagdMetadata[NamespacedMetadataKey("flagd", MetadataSuccessfulProviderName)])
+		assert.NotContains(t, flagdMetadata, MetadataStrategyUsed)
+	})
+
+	t.Run("nested keys are namespaced per level", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 1)
+		mocks["0"].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.BoolResolutionDetail{
+			Value:                    true,
+			ProviderResolutionDetail: of.ProviderResolutionDetail{FlagMetadata: of.FlagMetadata{"scope": "flagd"}},
+		})
+
+		inner, err := NewStrategyProvider("inner", providers, newFirstMatch)
+		require.NoError(t, err)
+		outer, err := NewStrategyProvider("outer", []*NamedProvider{{Name: "inner", Provider: inner}}, newFirstMatch)
+		require.NoError(t, err)
+
+		result := NewFirstMatchStrategy([]*NamedProvider{{Name: "outer", Provider: outer}}).
+			BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+		assert.Equal(t, of.FlagMetadata{
+			MetadataStrategyUsed:                                 StrategyFirstMatch,
+			MetadataSuccessfulProviderName:                       "outer",
+			"outer/multiprovider-strategy-used":                  StrategyFirstMatch,
+			"outer/multiprovider-successful-provider-name":       "inner",
+			"outer/inner/multiprovider-strategy-used":            StrategyFirstMatch,
+			"outer/inner/multiprovider-successful-provider-name": "0",
+			"scope": "flagd",
+		}, result.FlagMetadata)
+	})
+
+	t.Run("metadata & lifecycle", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, _ := createMockProviders(ctrl, 1)
+		failing := m.NewMockStateHandler(ctrl)
+		failing.EXPECT().Init(gomock.Any()).Return(errors.New("unreachable"))
+		failing.EXPECT().Shutdown()
+		providers = append(providers, &NamedProvider{Name: "1", Provider: struct {
+			of.FeatureProvider
+			of.StateHandler
+		}{m.NewMockFeatureProvider(ctrl), failing}})
+
+		nested, err := NewStrategyProvider("group", providers, newFirstMatch)
+		require.NoError(t, err)
+		assert.Equal(t, "group {strategy-first-match: 0, 1}", nested.Metadata().Name)
+		assert.Equal(t, StrategyFirstMatch, nested.Strategy().Name())
+		assert.Len(t, nested.Providers(), 2)
+		assert.Empty(t, nested.Hooks())
+
+		assert.EqualError(t, nested.Init(of.EvaluationContext{}), "Provider 1: unreachable")
+		nested.Shutdown()
+	})
+
+	t.Run("the strategy is created from the providers", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, _ := createMockProviders(ctrl, 2)
+
+		var received []*NamedProvider
+		nested, err := NewStrategyProvider("group", providers, func(p []*NamedProvider) (Strategy, error) {
+			received = p
+			return NewFirstMatchStrategy(p), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, providers, received)
+		assert.Equal(t, providers, nested.Providers())
+
+		_, err = NewStrategyProvider("group", providers, func([]*NamedProvider) (Strategy, error) {
+			return nil, errors.New("quorum too high")
+		})
+		assert.EqualError(t, err, "failed to create the strategy of group: quorum too high")
+		_, err = NewStrategyProvider("group", providers, func([]*NamedProvider) (Strategy, error) { return nil, nil })
+		assert.Error(t, err)
+		_, err = NewStrategyProvider("group", providers, nil)
+		assert.Error(t, err)
+		_, err = NewStrategyProvider("group", nil, newFirstMatch)
+		assert.Error(t, err)
+	})
+}
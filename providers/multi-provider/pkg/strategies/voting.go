@@ -0,0 +1,296 @@
+package strategies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	mperr "github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg/errors"
+	of "github.com/open-feature/go-sdk/openfeature"
+)
+
+const (
+	MetadataDissentingProviders  = "multiprovider-dissenting-providers"
+	MetadataAbstainingProviders  = "multiprovider-abstaining-providers"
+	MetadataVoteWeight           = "multiprovider-vote-weight"
+	MetadataVoteTie              = "multiprovider-vote-tie"
+	errVotingNoQuorumText        = "no value reached the required quorum"
+	errVotingTieText             = "vote is tied between multiple values"
+	errVotingProviderTimeoutText = "provider did not respond before the timeout"
+)
+
+type (
+	// VotingStrategy All providers are evaluated in parallel and vote for the value they resolved. The value whose voters
+	// have a combined weight of at least the quorum wins. Providers returning an error, including FLAG_NOT_FOUND,
+	// abstain from the vote.
+	VotingStrategy struct {
+		providers []*NamedProvider
+		quorum    int
+		weights   map[string]int
+		timeout   time.Duration
+	}
+
+	// voteGroup Providers that voted for the same value
+	voteGroup[R resultConstraint] struct {
+		first  resultWrapper[R]
+		weight int
+		voters []string
+	}
+)
+
+var _ Strategy = (*VotingStrategy)(nil)
+
+// NewVotingStrategy Creates a new VotingStrategy instance. Providers without an entry in weights have a weight of 1.
+// If quorum is 0 a value needs a strict majority of the combined weight of all providers to win. The timeout limits the
+// total runtime of the parallel evaluation, providers not responding in time abstain.
+func NewVotingStrategy(providers []*NamedProvider, quorum int, weights map[string]int, timeout time.Duration) (*VotingStrategy, error) {
+	known := make(map[string]bool, len(providers))
+	totalWeight := 0
+	for _, p := range providers {
+		known[p.Name] = true
+		weight, ok := weights[p.Name]
+		if !ok {
+			weight = 1
+		}
+		if weight <= 0 {
+			return nil, fmt.Errorf("weight of provider %s must be positive", p.Name)
+		}
+		totalWeight += weight
+	}
+	for name := range weights {
+		if !known[name] {
+			return nil, fmt.Errorf("weight set for unknown provider %s", name)
+		}
+	}
+
+	if quorum < 0 {
+		return nil, errors.New("quorum cannot be negative")
+	}
+	if quorum == 0 {
+		quorum = totalWeight/2 + 1
+	}
+	if quorum > totalWeight {
+		return nil, fmt.Errorf("quorum %d exceeds the total weight %d of all providers", quorum, totalWeight)
+	}
+
+	return &VotingStrategy{
+		providers: providers,
+		quorum:    quorum,
+		weights:   weights,
+		timeout:   timeout,
+	}, nil
+}
+
+func (v *VotingStrategy) Name() EvaluationStrategy {
+	return StrategyVoting
+}
+
+func (v *VotingStrategy) BooleanEvaluation(ctx context.Context, flag string, defaultValue bool, evalCtx of.FlattenedContext) of.BoolResolutionDetail {
+	evalFunc := func(c context.Context, p *NamedProvider) resultWrapper[of.BoolResolutionDetail] {
+		result := p.Provider.BooleanEvaluation(c, flag, defaultValue, evalCtx)
+		return resultWrapper[of.BoolResolutionDetail]{
+			result: &result,
+			name:   p.Name,
+			value:  result.Value,
+			detail: result.ProviderResolutionDetail,
+		}
+	}
+	result := evaluateVoting(ctx, v, evalFunc, defaultValue)
+	result.result.ProviderResolutionDetail = result.detail
+	return *result.result
+}
+
+func (v *VotingStrategy) StringEvaluation(ctx context.Context, flag string, defaultValue string, evalCtx of.FlattenedContext) of.StringResolutionDetail {
+	evalFunc := func(c context.Context, p *NamedProvider) resultWrapper[of.StringResolutionDetail] {
+		result := p.Provider.StringEvaluation(c, flag, defaultValue, evalCtx)
+		return resultWrapper[of.StringResolutionDetail]{
+			result: &result,
+			name:   p.Name,
+			value:  result.Value,
+			detail: result.ProviderResolutionDetail,
+		}
+	}
+	result := evaluateVoting(ctx, v, evalFunc, defaultValue)
+	result.result.ProviderResolutionDetail = result.detail
+	return *result.result
+}
+
+func (v *VotingStrategy) FloatEvaluation(ctx context.Context, flag string, defaultValue float64, evalCtx of.FlattenedContext) of.FloatResolutionDetail {
+	evalFunc := func(c context.Context, p *NamedProvider) resultWrapper[of.FloatResolutionDetail] {
+		result := p.Provider.FloatEvaluation(c, flag, defaultValue, evalCtx)
+		return resultWrapper[of.FloatResolutionDetail]{
+			result: &result,
+			name:   p.Name,
+			value:  result.Value,
+			detail: result.ProviderResolutionDetail,
+		}
+	}
+	result := evaluateVoting(ctx, v, evalFunc, defaultValue)
+	result.result.ProviderResolutionDetail = result.detail
+	return *result.result
+}
+
+func (v *VotingStrategy) IntEvaluation(ctx context.Context, flag string, defaultValue int64, evalCtx of.FlattenedContext) of.IntResolutionDetail {
+	evalFunc := func(c context.Context, p *NamedProvider) resultWrapper[of.IntResolutionDetail] {
+		result := p.Provider.IntEvaluation(c, flag, defaultValue, evalCtx)
+		return resultWrapper[of.IntResolutionDetail]{
+			result: &result,
+			name:   p.Name,
+			value:  result.Value,
+			detail: result.ProviderResolutionDetail,
+		}
+	}
+	result := evaluateVoting(ctx, v, evalFunc, defaultValue)
+	result.result.ProviderResolutionDetail = result.detail
+	return *result.result
+}
+
+func (v *VotingStrategy) ObjectEvaluation(ctx context.Context, flag string, defaultValue interface{}, evalCtx of.FlattenedContext) of.InterfaceResolutionDetail {
+	evalFunc := func(c context.Context, p *NamedProvider) resultWrapper[of.InterfaceResolutionDetail] {
+		result := p.Provider.ObjectEvaluation(c, flag, defaultValue, evalCtx)
+		return resultWrapper[of.InterfaceResolutionDetail]{
+			result: &result,
+			name:   p.Name,
+			value:  result.Value,
+			detail: result.ProviderResolutionDetail,
+		}
+	}
+	result := evaluateVoting(ctx, v, evalFunc, defaultValue)
+	result.result.ProviderResolutionDetail = result.detail
+	return *result.result
+}
+
+func (v *VotingStrategy) weight(name string) int {
+	if weight, ok := v.weights[name]; ok {
+		return weight
+	}
+	return 1
+}
+
+// evaluateVoting Evaluates all providers in parallel, groups the results by value and determines the winning value
+func evaluateVoting[R resultConstraint, DV bool | string | int64 | float64 | interface{}](ctx context.Context, v *VotingStrategy, e evaluator[R], defaultVal DV) resultWrapper[R] {
+	ctx, cancel := context.WithTimeout(ctx, v.timeout)
+	defer cancel()
+
+	resultChan := make(chan resultWrapper[R], len(v.providers))
+	for _, provider := range v.providers {
+		go func(p *NamedProvider) {
+			resultChan <- e(ctx, p)
+		}(provider)
+	}
+
+	responses := make(map[string]resultWrapper[R], len(v.providers))
+collect:
+	for len(responses) < len(v.providers) {
+		select {
+		case r := <-resultChan:
+			responses[r.name] = r
+		case <-ctx.Done():
+			break collect
+		}
+	}
+
+	// group the votes in provider order, so the first provider of each group determines the variant & metadata
+	groups := make([]*voteGroup[R], 0, len(v.providers))
+	abstaining := make([]string, 0, len(v.providers))
+	errs := make([]mperr.ProviderError, 0, len(v.providers))
+	notFound := make([]mperr.ProviderError, 0, len(v.providers))
+	for _, p := range v.providers {
+		r, responded := responses[p.Name]
+		if !responded {
+			abstaining = append(abstaining, p.Name)
+			errs = append(errs, mperr.ProviderError{ProviderName: p.Name, Err: errors.New(errVotingProviderTimeoutText)})
+			continue
+		}
+		if r.detail.Error() != nil {
+			abstaining = append(abstaining, p.Name)
+			if r.detail.ResolutionDetail().ErrorCode == of.FlagNotFoundCode {
+				notFound = append(notFound, mperr.ProviderError{ProviderName: p.Name, Err: r.detail.ResolutionError})
+			} else {
+				errs = append(errs, mperr.ProviderError{ProviderName: p.Name, Err: r.detail.ResolutionError})
+			}
+			continue
+		}
+
+		var group *voteGroup[R]
+		for _, g := range groups {
+			if JSONEqual(g.first.value, r.value) {
+				group = g
+				break
+			}
+		}
+		if group == nil {
+			group = &voteGroup[R]{first: r}
+			groups = append(groups, group)
+		}
+		group.weight += v.weight(p.Name)
+		group.voters = append(group.voters, p.Name)
+	}
+
+	if len(notFound) == len(v.providers) {
+		result := buildDefaultResult[R](StrategyVoting, defaultVal, nil)
+		setProviderErrors(result.detail.FlagMetadata, notFound...)
+		return result
+	}
+
+	var winner *voteGroup[R]
+	tie := false
+	for _, g := range groups {
+		switch {
+		case winner == nil || g.weight > winner.weight:
+			winner = g
+			tie = false
+		case g.weight == winner.weight:
+			tie = true
+		}
+	}
+
+	// ties only matter if the tied values reach the quorum
+	decided := winner != nil && winner.weight >= v.quorum && !tie
+	tie = tie && winner.weight >= v.quorum
+
+	dissenting := make([]string, 0, len(v.providers))
+	for _, g := range groups {
+		if g != winner || !decided {
+			dissenting = append(dissenting, g.voters...)
+		}
+	}
+	SortByProviderOrder(v.providers, dissenting, func(name string) string { return name })
+
+	if !decided {
+		var err error
+		switch {
+		case winner == nil && len(errs) > 0:
+			err = mperr.NewAggregateError(errs)
+		case tie:
+			err = errors.New(errVotingTieText)
+		default:
+			err = errors.New(errVotingNoQuorumText)
+		}
+		result := buildDefaultResult[R](StrategyVoting, defaultVal, err)
+		result.detail.FlagMetadata[MetadataDissentingProviders] = strings.Join(dissenting, ", ")
+		result.detail.FlagMetadata[MetadataAbstainingProviders] = strings.Join(abstaining, ", ")
+		result.detail.FlagMetadata[MetadataVoteTie] = tie
+		setProviderErrors(result.detail.FlagMetadata, append(errs, notFound...)...)
+		return result
+	}
+
+	result := winner.first
+	metadata := mergeFlagTags(result.detail.FlagMetadata, of.FlagMetadata{
+		MetadataStrategyUsed:                 StrategyVoting,
+		MetadataSuccessfulProviderName:       result.name,
+		MetadataSuccessfulProviderName + "s": strings.Join(winner.voters, ", "),
+		MetadataDissentingProviders:          strings.Join(dissenting, ", "),
+		MetadataAbstainingProviders:          strings.Join(abstaining, ", "),
+		MetadataVoteWeight:                   winner.weight,
+		MetadataVoteTie:                      false,
+	})
+	result.detail = of.ProviderResolutionDetail{
+		Reason:       ReasonAggregated,
+		Variant:      result.detail.Variant,
+		FlagMetadata: metadata,
+	}
+	return result
+}
@@ -0,0 +1,155 @@
+package strategies
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_VotingStrategy(t *testing.T) {
+	boolResult := func(value bool, variant string) of.BoolResolutionDetail {
+		return of.BoolResolutionDetail{Value: value, ProviderResolutionDetail: of.ProviderResolutionDetail{Variant: variant}}
+	}
+
+	t.Run("majority outvotes a single bad provider", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 3)
+		mocks["0"].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(boolResult(false, "off"))
+		mocks["1"].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(boolResult(true, "on"))
+		mocks["2"].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(boolResult(true, "on"))
+
+		strategy, err := NewVotingStrategy(providers, 0, nil, time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, StrategyVoting, strategy.Name())
+		result := strategy.BooleanEvaluation(context.Background(), "kill-switch", false, of.FlattenedContext{})
+		assert.True(t, result.Value)
+		assert.Equal(t, "on", result.Variant)
+		assert.Equal(t, ReasonAggregated, result.Reason)
+		require.NoError(t, result.Error())
+		assert.Equal(t, "1", result.FlagMetadata[MetadataSuccessfulProviderName])
+		assert.Equal(t, "1, 2", result.FlagMetadata[MetadataSuccessfulProviderName+"s"])
+		assert.Equal(t, "0", result.FlagMetadata[MetadataDissentingProviders])
+		assert.Equal(t, "", result.FlagMetadata[MetadataAbstainingProviders])
+		assert.Equal(t, 2, result.FlagMetadata[MetadataVoteWeight])
+		assert.Equal(t, StrategyVoting, result.FlagMetadata[MetadataStrategyUsed])
+	})
+
+	t.Run("errors abstain from the vote", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 3)
+		mocks["0"].EXPECT().StringEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.StringResolutionDetail{
+			ProviderResolutionDetail: of.ProviderResolutionDetail{ResolutionError: of.NewGeneralResolutionError("boom")},
+		})
+		mocks["1"].EXPECT().StringEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.StringResolutionDetail{Value: "a"})
+		mocks["2"].EXPECT().StringEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.StringResolutionDetail{Value: "a"})
+
+		strategy, err := NewVotingStrategy(providers, 2, nil, time.Second)
+		require.NoError(t, err)
+		result := strategy.StringEvaluation(context.Background(), "flag", "default", of.FlattenedContext{})
+		assert.Equal(t, "a", result.Value)
+		assert.Equal(t, "0", result.FlagMetadata[MetadataAbstainingProviders])
+		assert.Equal(t, "", result.FlagMetadata[MetadataDissentingProviders])
+	})
+
+	t.Run("quorum not reached returns the default value", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 3)
+		mocks["0"].EXPECT().IntEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.IntResolutionDetail{Value: 1})
+		mocks["1"].EXPECT().IntEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.IntResolutionDetail{Value: 2})
+		mocks["2"].EXPECT().IntEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.IntResolutionDetail{Value: 3})
+
+		strategy, err := NewVotingStrategy(providers, 2, nil, time.Second)
+		require.NoError(t, err)
+		result := strategy.IntEvaluation(context.Background(), "flag", 7, of.FlattenedContext{})
+		assert.Equal(t, int64(7), result.Value)
+		assert.Equal(t, of.GeneralCode, result.ResolutionDetail().ErrorCode)
+		assert.Equal(t, "no value reached the required quorum", result.ResolutionDetail().ErrorMessage)
+		assert.Equal(t, "0, 1, 2", result.FlagMetadata[MetadataDissentingProviders])
+		assert.Equal(t, false, result.FlagMetadata[MetadataVoteTie])
+	})
+
+	t.Run("tie returns the default value", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 4)
+		mocks["0"].EXPECT().FloatEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.FloatResolutionDetail{Value: 1})
+		mocks["1"].EXPECT().FloatEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.FloatResolutionDetail{Value: 2})
+		mocks["2"].EXPECT().FloatEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.FloatResolutionDetail{Value: 1})
+		mocks["3"].EXPECT().FloatEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.FloatResolutionDetail{Value: 2})
+
+		strategy, err := NewVotingStrategy(providers, 2, nil, time.Second)
+		require.NoError(t, err)
+		result := strategy.FloatEvaluation(context.Background(), "flag", 0.5, of.FlattenedContext{})
+		assert.Equal(t, 0.5, result.Value)
+		assert.Equal(t, "vote is tied between multiple values", result.ResolutionDetail().ErrorMessage)
+		assert.Equal(t, true, result.FlagMetadata[MetadataVoteTie])
+		assert.Equal(t, "0, 1, 2, 3", result.FlagMetadata[MetadataDissentingProviders])
+	})
+
+	t.Run("weighted majority", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 3)
+		mocks["0"].EXPECT().ObjectEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.InterfaceResolutionDetail{Value: map[string]any{"a": 1}})
+		mocks["1"].EXPECT().ObjectEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.InterfaceResolutionDetail{Value: map[string]any{"b": 1}})
+		mocks["2"].EXPECT().ObjectEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(of.InterfaceResolutionDetail{Value: map[string]any{"b": 1}})
+
+		strategy, err := NewVotingStrategy(providers, 0, map[string]int{"0": 3}, time.Second)
+		require.NoError(t, err)
+		result := strategy.ObjectEvaluation(context.Background(), "flag", nil, of.FlattenedContext{})
+		assert.Equal(t, map[string]any{"a": 1}, result.Value)
+		assert.Equal(t, 3, result.FlagMetadata[MetadataVoteWeight])
+		assert.Equal(t, "1, 2", result.FlagMetadata[MetadataDissentingProviders])
+	})
+
+	t.Run("not found in all providers", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 2)
+		notFound := of.BoolResolutionDetail{
+			ProviderResolutionDetail: of.ProviderResolutionDetail{ResolutionError: of.NewFlagNotFoundResolutionError("not found")},
+		}
+		mocks["0"].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound)
+		mocks["1"].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound)
+
+		strategy, err := NewVotingStrategy(providers, 1, nil, time.Second)
+		require.NoError(t, err)
+		result := strategy.BooleanEvaluation(context.Background(), "flag", true, of.FlattenedContext{})
+		assert.True(t, result.Value)
+		assert.Equal(t, of.FlagNotFoundCode, result.ResolutionDetail().ErrorCode)
+	})
+
+	t.Run("slow provider abstains after the timeout", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, mocks := createMockProviders(ctrl, 3)
+		mocks["0"].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(boolResult(true, "on"))
+		mocks["1"].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(boolResult(true, "on"))
+		mocks["2"].EXPECT().BooleanEvaluation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, _ string, _ bool, _ of.FlattenedContext) of.BoolResolutionDetail {
+				<-ctx.Done()
+				return boolResult(false, "off")
+			})
+
+		strategy, err := NewVotingStrategy(providers, 0, nil, 50*time.Millisecond)
+		require.NoError(t, err)
+		result := strategy.BooleanEvaluation(context.Background(), "flag", false, of.FlattenedContext{})
+		assert.True(t, result.Value)
+		assert.Equal(t, "2", result.FlagMetadata[MetadataAbstainingProviders])
+	})
+
+	t.Run("invalid configuration", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		providers, _ := createMockProviders(ctrl, 3)
+
+		_, err := NewVotingStrategy(providers, -1, nil, time.Second)
+		require.EqualError(t, err, "quorum cannot be negative")
+		_, err = NewVotingStrategy(providers, 4, nil, time.Second)
+		require.EqualError(t, err, "quorum 4 exceeds the total weight 3 of all providers")
+		_, err = NewVotingStrategy(providers, 0, map[string]int{"unknown": 1}, time.Second)
+		require.EqualError(t, err, "weight set for unknown provider unknown")
+		_, err = NewVotingStrategy(providers, 0, map[string]int{"0": 0}, time.Second)
+		require.EqualError(t, err, "weight of provider 0 must be positive")
+	})
+}
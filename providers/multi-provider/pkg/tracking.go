@@ -0,0 +1,120 @@
+package multiprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+
+	mperr "github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg/errors"
+	"github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg/strategies"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+)
+
+// errorTracker Optional interface for internal providers able to report a failure to deliver a tracking event. If an
+// internal provider implements it, TrackWithError is used instead of of.Tracker's Track.
+type errorTracker interface {
+	TrackWithError(ctx context.Context, trackingEventName string, evaluationContext of.EvaluationContext, details of.TrackingEventDetails) error
+}
+
+var (
+	_ of.Tracker   = (*MultiProvider)(nil)
+	_ errorTracker = (*MultiProvider)(nil)
+)
+
+// Track Forwards the tracking event to every internal provider supporting tracking, or to the providers set via
+// WithTrackingProviders. Failures are logged, use TrackWithError to handle them instead.
+func (mp *MultiProvider) Track(ctx context.Context, trackingEventName string, evaluationContext of.EvaluationContext, details of.TrackingEventDetails) {
+	if err := mp.TrackWithError(ctx, trackingEventName, evaluationContext, details); err != nil {
+		mp.logger.LogAttrs(ctx, slog.LevelError, "failed to forward tracking event",
+			slog.String("tracking-event", trackingEventName),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// TrackWithError Forwards the tracking event to every internal provider supporting tracking, or to the providers set
+// via WithTrackingProviders, in parallel. Providers that are not ready or fatal, including before Init has finished, do
+// not receive the event, which is logged as a warning. Failures of the internal providers are returned as an
+// mperr.AggregateError ordered like the providers.
+func (mp *MultiProvider) TrackWithError(ctx context.Context, trackingEventName string, evaluationContext of.EvaluationContext, details of.TrackingEventDetails) error {
+	providerStatus := mp.ProviderStatus()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	errs := make([]mperr.ProviderError, 0)
+	for _, p := range mp.trackingProviders() {
+		wg.Go(func() {
+			var err error
+			switch state := providerStatus[p.Name]; state {
+			case of.NotReadyState, of.FatalState:
+				mp.logger.LogAttrs(ctx, slog.LevelWarn, "dropped tracking event, provider is not ready",
+					slog.String(MetadataProviderName, p.Name),
+					slog.String("tracking-event", trackingEventName),
+					slog.String("state", string(state)),
+				)
+				err = fmt.Errorf("tracking event dropped, provider state is %s", state)
+			default:
+				err = trackProvider(ctx, p.Provider, trackingEventName, evaluationContext, details)
+			}
+			if err == nil {
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			errs = append(errs, mperr.ProviderError{ProviderName: p.Name, Err: err})
+		})
+	}
+	wg.Wait()
+
+	if len(errs) == 0 {
+		return nil
+	}
+	strategies.SortByProviderOrder(mp.current.Load().providerList, errs, func(e mperr.ProviderError) string { return e.ProviderName })
+	return mperr.NewAggregateError(errs)
+}
+
+// trackingProviders returns the internal providers tracking events are forwarded to, in provider order
+func (mp *MultiProvider) trackingProviders() []*strategies.NamedProvider {
+	providerList := mp.current.Load().providerList
+	providers := make([]*strategies.NamedProvider, 0, len(providerList))
+	for _, p := range providerList {
+		if mp.trackingNames != nil && !slices.Contains(mp.trackingNames, p.Name) {
+			continue
+		}
+		if supportsTracking(p.Provider) {
+			providers = append(providers, p)
+		}
+	}
+	return providers
+}
+
+// trackProvider forwards the tracking event to a single provider, converting a panic into an error
+func trackProvider(ctx context.Context, provider of.FeatureProvider, trackingEventName string, evaluationContext of.EvaluationContext, details of.TrackingEventDetails) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("tracking panicked: %v", r)
+		}
+	}()
+
+	if tracker, ok := provider.(errorTracker); ok {
+		return tracker.TrackWithError(ctx, trackingEventName, evaluationContext, details)
+	}
+	if tracker, ok := provider.(of.Tracker); ok {
+		tracker.Track(ctx, trackingEventName, evaluationContext, details)
+		return nil
+	}
+	return errors.New("provider does not support tracking")
+}
+
+func supportsTracking(provider of.FeatureProvider) bool {
+	switch provider.(type) {
+	case errorTracker, of.Tracker:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,149 @@
+package multiprovider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+
+	mperr "github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg/errors"
+	"github.com/open-feature/go-sdk-contrib/providers/multi-provider/pkg/strategies"
+	of "github.com/open-feature/go-sdk/openfeature"
+	imp "github.com/open-feature/go-sdk/openfeature/memprovider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type trackingProvider struct {
+	of.FeatureProvider
+	mu     sync.Mutex
+	events []string
+	err    error
+	panics bool
+}
+
+func newTrackingProvider() *trackingProvider {
+	return &trackingProvider{FeatureProvider: imp.NewInMemoryProvider(nil)}
+}
+
+func (p *trackingProvider) TrackWithError(_ context.Context, trackingEventName string, _ of.EvaluationContext, _ of.TrackingEventDetails) error {
+	if p.panics {
+		panic("collector unavailable")
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, trackingEventName)
+	return p.err
+}
+
+func (p *trackingProvider) tracked() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.events
+}
+
+// nonTrackingProvider hides the optional interfaces of the wrapped provider
+type nonTrackingProvider struct {
+	of.FeatureProvider
+}
+
+// plainTracker only implements of.Tracker
+type plainTracker struct {
+	of.FeatureProvider
+	recorder *trackingProvider
+}
+
+func (p *plainTracker) Track(ctx context.Context, trackingEventName string, evalCtx of.EvaluationContext, details of.TrackingEventDetails) {
+	_ = p.recorder.TrackWithError(ctx, trackingEventName, evalCtx, details)
+}
+
+func TestMultiProvider_Track(t *testing.T) {
+	evalCtx := of.NewEvaluationContext("user", nil)
+	details := of.NewTrackingEventDetails(1)
+
+	t.Run("forwards to every provider supporting tracking", func(t *testing.T) {
+		tracker1 := newTrackingProvider()
+		recorder := newTrackingProvider()
+		tracker2 := &plainTracker{FeatureProvider: recorder.FeatureProvider, recorder: recorder}
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "tracker1", Provider: tracker1},
+			{Name: "no-tracking", Provider: &nonTrackingProvider{imp.NewInMemoryProvider(nil)}},
+			{Name: "tracker2", Provider: tracker2},
+		}, StrategyFirstMatch)
+		require.NoError(t, err)
+		require.NoError(t, mp.Init(evalCtx))
+		defer mp.Shutdown()
+
+		require.NoError(t, mp.TrackWithError(context.Background(), "checkout", evalCtx, details))
+		mp.Track(context.Background(), "purchase", evalCtx, details)
+		assert.Equal(t, []string{"checkout", "purchase"}, tracker1.tracked())
+		assert.Equal(t, []string{"checkout", "purchase"}, recorder.tracked())
+	})
+
+	t.Run("forwards to the chosen providers only", func(t *testing.T) {
+		tracker1 := newTrackingProvider()
+		tracker2 := newTrackingProvider()
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "tracker1", Provider: tracker1},
+			{Name: "tracker2", Provider: tracker2},
+		}, StrategyFirstMatch, WithTrackingProviders("tracker2"))
+		require.NoError(t, err)
+		require.NoError(t, mp.Init(evalCtx))
+		defer mp.Shutdown()
+
+		require.NoError(t, mp.TrackWithError(context.Background(), "checkout", evalCtx, details))
+		assert.Empty(t, tracker1.tracked())
+		assert.Equal(t, []string{"checkout"}, tracker2.tracked())
+	})
+
+	t.Run("failures are aggregated", func(t *testing.T) {
+		failing := newTrackingProvider()
+		failing.err = errors.New("collector rejected event")
+		panicking := newTrackingProvider()
+		panicking.panics = true
+		healthy := newTrackingProvider()
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "panicking", Provider: panicking},
+			{Name: "healthy", Provider: healthy},
+			{Name: "failing", Provider: failing},
+		}, StrategyFirstMatch)
+		require.NoError(t, err)
+		require.NoError(t, mp.Init(evalCtx))
+		defer mp.Shutdown()
+
+		err = mp.TrackWithError(context.Background(), "checkout", evalCtx, details)
+		var aggregateErr *mperr.AggregateError
+		require.ErrorAs(t, err, &aggregateErr)
+		assert.Len(t, *aggregateErr, 2)
+		assert.Equal(t, "Provider panicking: tracking panicked: collector unavailable, Provider failing: collector rejected event", err.Error())
+		assert.Equal(t, []string{"checkout"}, healthy.tracked())
+	})
+
+	t.Run("providers that are not ready do not receive events", func(t *testing.T) {
+		tracker := newTrackingProvider()
+		var logs bytes.Buffer
+		mp, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "tracker", Provider: tracker},
+		}, StrategyFirstMatch, WithLogger(slog.New(slog.NewTextHandler(&logs, nil))))
+		require.NoError(t, err)
+
+		err = mp.TrackWithError(context.Background(), "checkout", evalCtx, details)
+		assert.ErrorContains(t, err, "provider state is NOT_READY")
+		assert.Empty(t, tracker.tracked())
+		assert.Contains(t, logs.String(), `msg="dropped tracking event, provider is not ready" multiprovider-provider-name=tracker`)
+	})
+
+	t.Run("chosen providers are validated", func(t *testing.T) {
+		_, err := NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "tracker", Provider: newTrackingProvider()},
+		}, StrategyFirstMatch, WithTrackingProviders("unknown"))
+		assert.ErrorContains(t, err, "tracking provider unknown is not a known provider")
+
+		_, err = NewOrderedMultiProvider([]*strategies.NamedProvider{
+			{Name: "no-tracking", Provider: &nonTrackingProvider{imp.NewInMemoryProvider(nil)}},
+		}, StrategyFirstMatch, WithTrackingProviders("no-tracking"))
+		assert.ErrorContains(t, err, "tracking provider no-tracking does not support tracking")
+	})
+}